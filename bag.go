@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// commandBag takes no parameters and lists the items in the bag with their
+// quantity and category, using item names in the current language.
+// Pokedex > bag
+// Poke Ball x10 (standard-balls)
+// Potion x2 (healing)
+func commandBag(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(cfg.bag) == 0 {
+		fmt.Println("Your bag is empty.")
+		return nil
+	}
+
+	for _, slug := range cfg.bag.Items() {
+		item, err := pokeapi.GetItem(slug)
+		if err != nil {
+			fmt.Printf("%s x%d\n", slug, cfg.bag.Count(slug))
+			continue
+		}
+		fmt.Printf("%s x%d (%s)\n", cfg.localize("item", slug, item.Names), cfg.bag.Count(slug), item.Category.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/markcromwell/pokedexcli/internal/game"
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// newCaughtPokemon creates the record for a Pokemon just caught at the given
+// level in the current location area, rolling its IVs and nature with the
+// session's RNG.
+func (cfg *config) newCaughtPokemon(pokemon *pokeapi.Pokemon, level int) (*game.CaughtPokemon, error) {
+	natures, err := pokeapi.GetResourceNames("nature")
+	if err != nil {
+		return nil, err
+	}
+	if len(natures) == 0 {
+		return nil, fmt.Errorf("no natures available")
+	}
+
+	nature, err := pokeapi.GetNature(natures[cfg.rng.Intn(len(natures))])
+	if err != nil {
+		return nil, err
+	}
+
+	curve, err := cfg.growthCurve(pokemon.Species.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return game.NewCaughtPokemon(pokemon, level, game.RandomIVs(cfg.rng), nature, curve, cfg.location), nil
+}
+
+// nextFreeID returns next unless it would reuse the ID of a Pokemon owned,
+// in which case it returns one past the highest ID owned. IDs start at 1.
+func nextFreeID(owned map[int]*game.CaughtPokemon, next int) int {
+	next = max(next, 1)
+	for id := range owned {
+		next = max(next, id+1)
+	}
+	return next
+}
+
+// addCaught gives a newly caught Pokemon the next instance ID, adds it to the
+// Pokemon owned and marks its species as caught. It goes to the party, or to
+// the PC once the party is full; the box number is returned, 0 for the party.
+func (cfg *config) addCaught(pokemon *game.CaughtPokemon) (int, error) {
+	if cfg.storage == nil {
+		cfg.storage = game.NewStorage()
+	}
+	if cfg.caughtPokemons == nil {
+		cfg.caughtPokemons = make(map[int]*game.CaughtPokemon)
+	}
+	if cfg.speciesCaught == nil {
+		cfg.speciesCaught = make(map[string]bool)
+	}
+	cfg.nextPokemonID = nextFreeID(cfg.caughtPokemons, cfg.nextPokemonID)
+
+	pokemon.ID = cfg.nextPokemonID
+	box, err := cfg.storage.Place(pokemon.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	cfg.nextPokemonID++
+	cfg.caughtPokemons[pokemon.ID] = pokemon
+	cfg.speciesCaught[pokemon.Species] = true
+	return box, nil
+}
+
+// ownedPokemon returns the Pokemon owned, ordered by instance ID.
+func (cfg *config) ownedPokemon() []*game.CaughtPokemon {
+	owned := make([]*game.CaughtPokemon, 0, len(cfg.caughtPokemons))
+	for _, pokemon := range cfg.caughtPokemons {
+		owned = append(owned, pokemon)
+	}
+	sort.Slice(owned, func(i, j int) bool {
+		return owned[i].ID < owned[j].ID
+	})
+	return owned
+}
+
+// findOwned finds an owned Pokemon from user input: a bare number is an
+// instance ID, "#25" a National Dex number and anything else a name or
+// nickname. When several individuals match, the lowest ID is returned
+// along with every match.
+func (cfg *config) findOwned(words []string) (*game.CaughtPokemon, []*game.CaughtPokemon) {
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	if id, err := strconv.Atoi(words[0]); err == nil {
+		pokemon, found := cfg.caughtPokemons[id]
+		if !found {
+			return nil, nil
+		}
+		return pokemon, []*game.CaughtPokemon{pokemon}
+	}
+
+	dexID, byDex := 0, strings.HasPrefix(words[0], "#")
+	if byDex {
+		dexID, _ = parseID(words[0])
+	}
+	name := cfg.resolveName("pokemon", words)
+
+	var matches []*game.CaughtPokemon
+	for _, pokemon := range cfg.ownedPokemon() {
+		if byDex && pokemon.SpeciesID == dexID ||
+			!byDex && (pokemon.Name == name || pokemon.Species == name || strings.EqualFold(pokemon.Nickname, name)) {
+			matches = append(matches, pokemon)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, nil
+	}
+	return matches[0], matches
+}
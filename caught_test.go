@@ -0,0 +1,31 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/markcromwell/pokedexcli/internal/game"
+)
+
+func TestNextFreeID(t *testing.T) {
+	owned := map[int]*game.CaughtPokemon{1: {ID: 1}, 4: {ID: 4}}
+
+	cases := []struct {
+		name     string
+		owned    map[int]*game.CaughtPokemon
+		next     int
+		expected int
+	}{
+		{name: "new game", next: 0, expected: 1},
+		{name: "recorded next id", owned: owned, next: 7, expected: 7},
+		{name: "missing next id", owned: owned, next: 0, expected: 5},
+		{name: "stale next id", owned: owned, next: 3, expected: 5},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := nextFreeID(c.owned, c.next); actual != c.expected {
+				t.Errorf("Expected %d, got %d", c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// methodSummary aggregates the encounter details of a single method:
+// the overall level range and the combined chance.
+type methodSummary struct {
+	method   string
+	minLevel int
+	maxLevel int
+	chance   int
+}
+
+// defaultTime is the time of day encounters are looked up for unless --time
+// is given.
+const defaultTime = "day"
+
+// defaultConditions are the encounter condition values in effect besides the
+// time of day: no swarm, no Poke Radar, no game in the second slot, the radio
+// off and spring. Encounters needing any other value are left out, so chances
+// only add up over encounters that can happen at the same time.
+var defaultConditions = map[string]bool{
+	"swarm-no":      true,
+	"radar-off":     true,
+	"slot2-none":    true,
+	"radio-off":     true,
+	"season-spring": true,
+}
+
+// encounterTime returns the time of day chosen with --time: morning, day or
+// night.
+func encounterTime(flags map[string]string) (string, error) {
+	value, ok := flags["time"]
+	if !ok {
+		return defaultTime, nil
+	}
+	switch value {
+	case "morning", "day", "night":
+		return value, nil
+	}
+	return "", fmt.Errorf("unknown time '%s', expected morning, day or night", value)
+}
+
+// conditionsMet reports whether an encounter can happen at the time of day
+// under the default conditions.
+func conditionsMet(d pokeapi.EncounterDetail, timeOfDay string) bool {
+	for _, value := range d.ConditionValues {
+		if strings.HasPrefix(value.Name, "time-") {
+			if value.Name != "time-"+timeOfDay {
+				return false
+			}
+			continue
+		}
+		if !defaultConditions[value.Name] {
+			return false
+		}
+	}
+	return true
+}
+
+// summarizeEncounters groups the encounter details possible at the time of
+// day by method, keeping the order in which methods first appear. If method
+// is not empty only that method is kept.
+func summarizeEncounters(details []pokeapi.EncounterDetail, method, timeOfDay string) []methodSummary {
+	var summaries []methodSummary
+	index := map[string]int{}
+	for _, d := range details {
+		if method != "" && d.Method.Name != method {
+			continue
+		}
+		if !conditionsMet(d, timeOfDay) {
+			continue
+		}
+
+		i, seen := index[d.Method.Name]
+		if !seen {
+			index[d.Method.Name] = len(summaries)
+			summaries = append(summaries, methodSummary{
+				method:   d.Method.Name,
+				minLevel: d.MinLevel,
+				maxLevel: d.MaxLevel,
+				chance:   d.Chance,
+			})
+			continue
+		}
+
+		s := &summaries[i]
+		s.minLevel = min(s.minLevel, d.MinLevel)
+		s.maxLevel = max(s.maxLevel, d.MaxLevel)
+		s.chance += d.Chance
+	}
+	return summaries
+}
+
+// levelRange formats a level range, collapsing it when min and max match.
+func levelRange(minLevel, maxLevel int) string {
+	if minLevel == maxLevel {
+		return fmt.Sprintf("lv %d", minLevel)
+	}
+	return fmt.Sprintf("lv %d-%d", minLevel, maxLevel)
+}
+
+// filterVersions returns the version details matching version, or all of them if version is empty.
+func filterVersions(details []pokeapi.VersionEncounterDetail, version string) []pokeapi.VersionEncounterDetail {
+	if version == "" {
+		return details
+	}
+
+	var filtered []pokeapi.VersionEncounterDetail
+	for _, d := range details {
+		if d.Version.Name == version {
+			filtered = append(filtered, d)
+		}
+	}
+	return filtered
+}
+
+// printEncounterTable prints one row per Pokemon, version and method with the
+// level range and combined chance at the time of day, optionally filtered by
+// version and method. Pokemon names are printed in the configured language.
+func (cfg *config) printEncounterTable(encounters []pokeapi.PokemonEncounter, version, method, timeOfDay string) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, " POKEMON\tVERSION\tMETHOD\tLEVELS\tCHANCE")
+	rows := 0
+	for _, encounter := range encounters {
+		for _, v := range filterVersions(encounter.VersionDetails, version) {
+			for _, s := range summarizeEncounters(v.EncounterDetails, method, timeOfDay) {
+				fmt.Fprintf(w, " %s\t%s\t%s\t%s\t%d%%\n", cfg.pokemonName(encounter.Pokemon.Name), v.Version.Name, s.method, levelRange(s.minLevel, s.maxLevel), s.chance)
+				rows++
+			}
+		}
+	}
+	if rows == 0 {
+		fmt.Println(" (no encounters match)")
+		return
+	}
+	w.Flush()
+}
+
+// printEncounterRates prints, for each encounter method, how likely an
+// encounter is to be triggered in each version.
+func printEncounterRates(rates []pokeapi.EncounterMethodRate, version, method string) {
+	var lines []string
+	for _, rate := range rates {
+		if method != "" && rate.EncounterMethod.Name != method {
+			continue
+		}
+
+		var perVersion []string
+		for _, v := range rate.VersionDetails {
+			if version != "" && v.Version.Name != version {
+				continue
+			}
+			perVersion = append(perVersion, fmt.Sprintf("%s %d%%", v.Version.Name, v.Rate))
+		}
+		if len(perVersion) == 0 {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf(" - %s: %s", rate.EncounterMethod.Name, strings.Join(perVersion, ", ")))
+	}
+
+	if len(lines) == 0 {
+		return
+	}
+	fmt.Println("Encounter rates:")
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+}
+
+// commandWhere expects the name of a Pokemon and optional --version and --time
+// flags. It fetches the Pokemon's location_area_encounters and prints every
+// location area it can be found in at that time of day, best chance first.
+// Pokedex > where pikachu --version red
+// Locations where pikachu can be found:
+// viridian-forest-area (best chance 5%)
+//   - red: walk lv 3-5, 5%
+func commandWhere(commands map[string]cliCommand, cfg *config, param []string) error {
+	args, flags := parseArgs(param)
+	if len(args) == 0 {
+		return fmt.Errorf("please specify a Pokemon to look up")
+	}
+	version := flags["version"]
+	timeOfDay, err := encounterTime(flags)
+	if err != nil {
+		return err
+	}
+
+	pokemonName, err := cfg.resolveResource("pokemon", args)
+	if err != nil {
+		return err
+	}
+	pokemon, err := pokeapi.GetPokemonSummary(pokemonName)
+	if err != nil {
+		return fmt.Errorf("could not find Pokemon '%s': %v", pokemonName, err)
+	}
+
+	encounters, err := pokeapi.GetPokemonEncounters(pokemon.LocationAreaEncounters)
+	if err != nil {
+		return err
+	}
+
+	type versionSummary struct {
+		version string
+		methodSummary
+	}
+	type areaResult struct {
+		name      string
+		best      int
+		summaries []versionSummary
+	}
+	var areas []areaResult
+	for _, e := range encounters {
+		area := areaResult{name: e.LocationArea.Name}
+		for _, v := range filterVersions(e.VersionDetails, version) {
+			for _, s := range summarizeEncounters(v.EncounterDetails, "", timeOfDay) {
+				area.best = max(area.best, s.chance)
+				area.summaries = append(area.summaries, versionSummary{v.Version.Name, s})
+			}
+		}
+		if len(area.summaries) > 0 {
+			areas = append(areas, area)
+		}
+	}
+
+	if len(areas) == 0 {
+		if version != "" {
+			fmt.Printf("%s cannot be found in the wild in %s.\n", pokemon.Name, version)
+		} else {
+			fmt.Printf("%s cannot be found in the wild.\n", pokemon.Name)
+		}
+		return nil
+	}
+
+	sort.SliceStable(areas, func(i, j int) bool {
+		return areas[i].best > areas[j].best
+	})
+
+	fmt.Printf("Locations where %s can be found:\n", pokemon.Name)
+	for _, area := range areas {
+		fmt.Printf("%s (best chance %d%%)\n", area.name, area.best)
+		for _, s := range area.summaries {
+			fmt.Printf("  - %s: %s %s, %d%%\n", s.version, s.method, levelRange(s.minLevel, s.maxLevel), s.chance)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+func TestSummarizeEncounters(t *testing.T) {
+	walk := pokeapi.NamedAPIResource{Name: "walk"}
+	surf := pokeapi.NamedAPIResource{Name: "surf"}
+	details := []pokeapi.EncounterDetail{
+		{MinLevel: 3, MaxLevel: 3, Chance: 20, Method: walk},
+		{MinLevel: 5, MaxLevel: 6, Chance: 10, Method: walk},
+		{MinLevel: 20, MaxLevel: 30, Chance: 60, Method: surf},
+	}
+
+	summaries := summarizeEncounters(details, "", defaultTime)
+	expected := []methodSummary{
+		{method: "walk", minLevel: 3, maxLevel: 6, chance: 30},
+		{method: "surf", minLevel: 20, maxLevel: 30, chance: 60},
+	}
+	if len(summaries) != len(expected) {
+		t.Fatalf("Expected %d summaries, got %d: %v", len(expected), len(summaries), summaries)
+	}
+	for i := range expected {
+		if summaries[i] != expected[i] {
+			t.Errorf("Summary %d mismatch: expected %v, got %v", i, expected[i], summaries[i])
+		}
+	}
+
+	surfOnly := summarizeEncounters(details, "surf", defaultTime)
+	if len(surfOnly) != 1 || surfOnly[0].method != "surf" {
+		t.Errorf("Expected only the surf summary, got %v", surfOnly)
+	}
+}
+
+func TestSummarizeEncountersConditions(t *testing.T) {
+	walk := pokeapi.NamedAPIResource{Name: "walk"}
+	conditions := func(names ...string) []pokeapi.NamedAPIResource {
+		var values []pokeapi.NamedAPIResource
+		for _, name := range names {
+			values = append(values, pokeapi.NamedAPIResource{Name: name})
+		}
+		return values
+	}
+	details := []pokeapi.EncounterDetail{
+		{MinLevel: 3, MaxLevel: 3, Chance: 20, Method: walk},
+		{MinLevel: 4, MaxLevel: 4, Chance: 10, Method: walk, ConditionValues: conditions("time-morning")},
+		{MinLevel: 5, MaxLevel: 5, Chance: 10, Method: walk, ConditionValues: conditions("time-day")},
+		{MinLevel: 6, MaxLevel: 6, Chance: 30, Method: walk, ConditionValues: conditions("time-night")},
+		{MinLevel: 7, MaxLevel: 7, Chance: 40, Method: walk, ConditionValues: conditions("swarm-yes")},
+		{MinLevel: 8, MaxLevel: 8, Chance: 5, Method: walk, ConditionValues: conditions("swarm-no", "radar-off")},
+		{MinLevel: 9, MaxLevel: 9, Chance: 15, Method: walk, ConditionValues: conditions("radar-on")},
+	}
+
+	cases := []struct {
+		timeOfDay string
+		expected  methodSummary
+	}{
+		{timeOfDay: "day", expected: methodSummary{method: "walk", minLevel: 3, maxLevel: 8, chance: 35}},
+		{timeOfDay: "morning", expected: methodSummary{method: "walk", minLevel: 3, maxLevel: 8, chance: 35}},
+		{timeOfDay: "night", expected: methodSummary{method: "walk", minLevel: 3, maxLevel: 8, chance: 55}},
+	}
+	for _, c := range cases {
+		summaries := summarizeEncounters(details, "", c.timeOfDay)
+		if len(summaries) != 1 || summaries[0] != c.expected {
+			t.Errorf("At %s: expected %v, got %v", c.timeOfDay, c.expected, summaries)
+		}
+	}
+}
+
+func TestEncounterTime(t *testing.T) {
+	if timeOfDay, err := encounterTime(map[string]string{}); err != nil || timeOfDay != defaultTime {
+		t.Errorf("Expected %s by default, got %s (err %v)", defaultTime, timeOfDay, err)
+	}
+	if timeOfDay, err := encounterTime(map[string]string{"time": "night"}); err != nil || timeOfDay != "night" {
+		t.Errorf("Expected night, got %s (err %v)", timeOfDay, err)
+	}
+	if _, err := encounterTime(map[string]string{"time": "dusk"}); err == nil {
+		t.Error("Expected an error for an unknown time of day")
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/markcromwell/pokedexcli/internal/game"
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// growthCurve returns the experience curve of a species. Curves are cached by
+// growth rate name for the session, since every battle won needs one and only
+// a handful of growth rates exist.
+func (cfg *config) growthCurve(species string) (game.GrowthCurve, error) {
+	rateName, ok := cfg.speciesGrowthRates[species]
+	if !ok {
+		details, err := pokeapi.GetPokemonSpecies(species)
+		if err != nil {
+			return nil, fmt.Errorf("could not get species '%s': %v", species, err)
+		}
+		rateName = details.GrowthRate.Name
+		if cfg.speciesGrowthRates == nil {
+			cfg.speciesGrowthRates = make(map[string]string)
+		}
+		cfg.speciesGrowthRates[species] = rateName
+	}
+
+	if curve, ok := cfg.growthCurves[rateName]; ok {
+		return curve, nil
+	}
+	rate, err := pokeapi.GetGrowthRate(rateName)
+	if err != nil {
+		return nil, fmt.Errorf("could not get growth rate '%s': %v", rateName, err)
+	}
+	curve := game.NewGrowthCurve(rate)
+	if cfg.growthCurves == nil {
+		cfg.growthCurves = make(map[string]game.GrowthCurve)
+	}
+	cfg.growthCurves[rateName] = curve
+	return curve, nil
+}
+
+// gainExp gives an owned Pokemon experience, announcing every level it grows
+// and recalculating its stats for the new level. The experience is kept even
+// when the growth curve or the details for the new stats can't be fetched;
+// the level then catches up on the next gain, and the error is returned.
+func (cfg *config) gainExp(pokemon *game.CaughtPokemon, exp int) error {
+	curve, err := cfg.growthCurve(pokemon.Species)
+	if err != nil {
+		pokemon.GainExp(exp, nil)
+		return err
+	}
+
+	updated := *pokemon
+	from := updated.GainExp(exp, curve)
+	if updated.Level != from {
+		details, err := pokeapi.GetPokemon(pokemon.Name)
+		if err != nil {
+			pokemon.Exp = updated.Exp
+			return err
+		}
+		nature, err := pokeapi.GetNature(pokemon.Nature)
+		if err != nil {
+			pokemon.Exp = updated.Exp
+			return err
+		}
+		updated.Recalculate(details, nature)
+	}
+	*pokemon = updated
+
+	fmt.Printf("%s gained %d EXP.\n", pokemon.DisplayName(), exp)
+	for level := from + 1; level <= pokemon.Level; level++ {
+		fmt.Printf("%s grew to level %d!\n", pokemon.DisplayName(), level)
+	}
+	return nil
+}
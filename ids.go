@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// maxDexIDs caps how many Pokemon a single dex command looks up.
+const maxDexIDs = 1025
+
+// dexConcurrency is how many Pokemon the dex command fetches at once.
+const dexConcurrency = 8
+
+// parseID parses a National Dex number written as "25" or "#25".
+func parseID(word string) (int, bool) {
+	id, err := strconv.Atoi(strings.TrimPrefix(word, "#"))
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
+
+// parseIDRanges parses IDs and ranges such as "1-151", "#25" or "1,4,7" into
+// a sorted list of unique IDs.
+func parseIDRanges(words []string) ([]int, error) {
+	seen := map[int]bool{}
+	for _, word := range words {
+		for _, part := range strings.Split(word, ",") {
+			if part == "" {
+				continue
+			}
+
+			first, last, isRange := strings.Cut(part, "-")
+			if !isRange {
+				last = first
+			}
+			from, ok := parseID(first)
+			if !ok {
+				return nil, fmt.Errorf("invalid ID '%s'", part)
+			}
+			to, ok := parseID(last)
+			if !ok || to < from {
+				return nil, fmt.Errorf("invalid ID range '%s'", part)
+			}
+			if to-from >= maxDexIDs {
+				return nil, fmt.Errorf("range '%s' is too large, at most %d IDs at a time", part, maxDexIDs)
+			}
+
+			for id := from; id <= to; id++ {
+				seen[id] = true
+			}
+		}
+	}
+
+	ids := make([]int, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	if len(ids) > maxDexIDs {
+		return nil, fmt.Errorf("too many IDs, at most %d at a time", maxDexIDs)
+	}
+	sort.Ints(ids)
+	return ids, nil
+}
+
+// commandDex expects one or more IDs or ranges and prints the Pokemon with
+// those National Dex numbers, marking the ones already caught.
+// Pokedex > dex 1-3
+// #001 bulbasaur (caught)
+// #002 ivysaur
+// #003 venusaur
+func commandDex(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("please specify an ID or a range, e.g. dex 1-151")
+	}
+
+	ids, err := parseIDRanges(param)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		names[i] = strconv.Itoa(id)
+	}
+
+	results := pokeapi.GetResourceBatch(context.Background(), "pokemon", names, dexConcurrency, pokeapi.ParsePokemonSummary)
+	for i, result := range results {
+		if result.Err != nil {
+			fmt.Printf("#%03d (unknown)\n", ids[i])
+			continue
+		}
+
+		pokemon := result.Value
+		if cfg.speciesCaught[pokemon.Species.Name] {
+			fmt.Printf("#%03d %s (caught)\n", pokemon.ID, pokemon.Name)
+		} else {
+			fmt.Printf("#%03d %s\n", pokemon.ID, pokemon.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseIDRanges(t *testing.T) {
+	cases := []struct {
+		input    []string
+		expected []int
+		wantErr  bool
+	}{
+		{input: []string{"25"}, expected: []int{25}},
+		{input: []string{"#25"}, expected: []int{25}},
+		{input: []string{"1-3"}, expected: []int{1, 2, 3}},
+		{input: []string{"7,1,4", "3-4"}, expected: []int{1, 3, 4, 7}},
+		{input: []string{"pikachu"}, wantErr: true},
+		{input: []string{"5-1"}, wantErr: true},
+		{input: []string{"0"}, wantErr: true},
+		{input: []string{"1-5000"}, wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(strings.Join(c.input, " "), func(t *testing.T) {
+			actual, err := parseIDRanges(c.input)
+			if c.wantErr {
+				if err == nil {
+					t.Errorf("Expected an error, got %v", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if !reflect.DeepEqual(actual, c.expected) {
+				t.Errorf("Expected %v, got %v", c.expected, actual)
+			}
+		})
+	}
+}
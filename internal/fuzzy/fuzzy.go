@@ -0,0 +1,87 @@
+package fuzzy
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxSuggestions is the most suggestions a Match returns.
+const maxSuggestions = 3
+
+// Result is the outcome of matching user input against a list of names.
+// Resolved is set when the input is an exact match or an unambiguous prefix;
+// otherwise Suggestions holds the closest names, best first.
+type Result struct {
+	Resolved    string
+	Suggestions []string
+}
+
+// Levenshtein returns the edit distance between a and b.
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// Match matches input against names. An exact match or a prefix shared by a
+// single name resolves directly. Several prefix matches are returned as
+// suggestions; failing that, names within a small edit distance are.
+func Match(input string, names []string) Result {
+	var prefixed []string
+	for _, name := range names {
+		if name == input {
+			return Result{Resolved: name}
+		}
+		if strings.HasPrefix(name, input) {
+			prefixed = append(prefixed, name)
+		}
+	}
+
+	if len(prefixed) == 1 {
+		return Result{Resolved: prefixed[0]}
+	}
+	if len(prefixed) > 1 {
+		sort.Slice(prefixed, func(i, j int) bool {
+			return len(prefixed[i]) < len(prefixed[j])
+		})
+		return Result{Suggestions: prefixed[:min(len(prefixed), maxSuggestions)]}
+	}
+
+	// allow roughly one typo per three characters, and at least two
+	maxDistance := max(2, len(input)/3)
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	for _, name := range names {
+		if d := Levenshtein(input, name); d <= maxDistance {
+			candidates = append(candidates, candidate{name, d})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	var suggestions []string
+	for _, c := range candidates[:min(len(candidates), maxSuggestions)] {
+		suggestions = append(suggestions, c.name)
+	}
+	return Result{Suggestions: suggestions}
+}
@@ -0,0 +1,47 @@
+package fuzzy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"pikachu", "pikachu", 0},
+		{"pikachuu", "pikachu", 1},
+		{"pikahcu", "pikachu", 2},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+	}
+	for _, c := range cases {
+		if actual := Levenshtein(c.a, c.b); actual != c.expected {
+			t.Errorf("Levenshtein(%q, %q): expected %d, got %d", c.a, c.b, c.expected, actual)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	names := []string{"pikachu", "pidgey", "pidgeotto", "pidgeot", "pallet-town-area", "bulbasaur"}
+	cases := []struct {
+		input    string
+		expected Result
+	}{
+		{"pikachu", Result{Resolved: "pikachu"}},
+		{"pallet-town", Result{Resolved: "pallet-town-area"}},
+		{"bulb", Result{Resolved: "bulbasaur"}},
+		{"pidg", Result{Suggestions: []string{"pidgey", "pidgeot", "pidgeotto"}}},
+		{"pikachuu", Result{Suggestions: []string{"pikachu"}}},
+		{"mewtwo", Result{}},
+	}
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			actual := Match(c.input, names)
+			if !reflect.DeepEqual(actual, c.expected) {
+				t.Errorf("Expected %+v, got %+v", c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Bag holds the player's items as quantities keyed by PokeAPI item name.
+type Bag map[string]int
+
+// StarterBag returns the items a new game starts with.
+func StarterBag() Bag {
+	return Bag{
+		"poke-ball": 10,
+		"potion":    2,
+	}
+}
+
+// Count returns how many of an item the bag holds.
+func (b Bag) Count(item string) int {
+	return b[item]
+}
+
+// Add puts qty of an item in the bag.
+func (b Bag) Add(item string, qty int) {
+	b[item] += qty
+}
+
+// Remove takes qty of an item out of the bag, failing if there aren't enough.
+// Items that run out are dropped from the bag.
+func (b Bag) Remove(item string, qty int) error {
+	if b[item] < qty {
+		return fmt.Errorf("you only have %d %s", b[item], item)
+	}
+	b[item] -= qty
+	if b[item] == 0 {
+		delete(b, item)
+	}
+	return nil
+}
+
+// Items returns the names of the items in the bag in sorted order.
+func (b Bag) Items() []string {
+	items := make([]string, 0, len(b))
+	for item := range b {
+		items = append(items, item)
+	}
+	sort.Strings(items)
+	return items
+}
@@ -0,0 +1,30 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBag(t *testing.T) {
+	bag := Bag{}
+	bag.Add("poke-ball", 2)
+	bag.Add("great-ball", 1)
+	bag.Add("poke-ball", 3)
+
+	if bag.Count("poke-ball") != 5 {
+		t.Errorf("Expected 5 poke-balls, got %d", bag.Count("poke-ball"))
+	}
+	if err := bag.Remove("poke-ball", 6); err == nil {
+		t.Error("Expected an error removing more than the bag holds")
+	}
+	if err := bag.Remove("great-ball", 1); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := bag.Remove("master-ball", 1); err == nil {
+		t.Error("Expected an error removing an item not in the bag")
+	}
+
+	if actual := bag.Items(); !reflect.DeepEqual(actual, []string{"poke-ball"}) {
+		t.Errorf("Expected only poke-ball left, got %v", actual)
+	}
+}
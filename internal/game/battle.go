@@ -0,0 +1,21 @@
+package game
+
+import "math/rand"
+
+// battlePower is the power of the single move every Pokémon uses in battle.
+const battlePower = 40
+
+// Damage rolls the damage an attacker at the given level deals to a defender
+// with the Gen III formula, using a move of battlePower and the attacker's
+// stronger side, physical or special. No types or critical hits are applied;
+// the usual 85-100% random factor is.
+func Damage(rng *rand.Rand, level int, attacker, defender Stats) int {
+	attack, defense := attacker.Attack, defender.Defense
+	if attacker.SpecialAttack > attacker.Attack {
+		attack, defense = attacker.SpecialAttack, defender.SpecialDefense
+	}
+	defense = max(defense, 1)
+
+	base := (2*level/5+2)*battlePower*attack/defense/50 + 2
+	return max(base*(85+rng.Intn(16))/100, 1)
+}
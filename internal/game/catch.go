@@ -0,0 +1,155 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Ball is a kind of Poké Ball and its catch rate multiplier.
+type Ball struct {
+	Name       string
+	Multiplier float64
+}
+
+// Balls are the balls that can be thrown, keyed by their PokeAPI item name.
+// The Master Ball's multiplier is high enough that it never fails.
+var Balls = map[string]Ball{
+	"poke-ball":   {Name: "Poke Ball", Multiplier: 1},
+	"great-ball":  {Name: "Great Ball", Multiplier: 1.5},
+	"ultra-ball":  {Name: "Ultra Ball", Multiplier: 2},
+	"master-ball": {Name: "Master Ball", Multiplier: 255},
+}
+
+// DefaultBall is the ball thrown when none is chosen.
+const DefaultBall = "poke-ball"
+
+// statusBonuses maps PokeAPI ailment names to their catch rate bonus.
+var statusBonuses = map[string]float64{
+	"":          1,
+	"none":      1,
+	"sleep":     2,
+	"freeze":    2,
+	"paralysis": 1.5,
+	"poison":    1.5,
+	"burn":      1.5,
+}
+
+// LookupBall returns the ball with the given item name.
+func LookupBall(name string) (Ball, error) {
+	ball, ok := Balls[name]
+	if !ok {
+		return Ball{}, fmt.Errorf("unknown ball '%s', expected one of %v", name, sortedNames(Balls))
+	}
+	return ball, nil
+}
+
+// StatusBonus returns the catch rate bonus for a status condition; an empty
+// status or "none" gives no bonus.
+func StatusBonus(status string) (float64, error) {
+	bonus, ok := statusBonuses[status]
+	if !ok {
+		return 0, fmt.Errorf("unknown status '%s', expected one of %v", status, sortedNames(statusBonuses))
+	}
+	return bonus, nil
+}
+
+func sortedNames[T any](m map[string]T) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		if name != "" && name != "none" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CatchValue computes the modified catch rate "a" of the Gen III formula from
+// the species' capture rate, the fraction of HP the Pokémon has left (0 to 1),
+// the ball multiplier and the status bonus. A value of 255 or more always
+// catches.
+func CatchValue(captureRate int, hpFraction, ball, status float64) float64 {
+	hpFraction = math.Max(0, math.Min(1, hpFraction))
+	return (1 - 2*hpFraction/3) * float64(captureRate) * ball * status
+}
+
+// ShakeProbability is the chance a single one of the four shake checks passes
+// for the catch value a.
+func ShakeProbability(a float64) float64 {
+	if a >= 255 {
+		return 1
+	}
+	if a <= 0 {
+		return 0
+	}
+	b := 1048560 / math.Sqrt(math.Sqrt(16711680/a))
+	return b / 65536
+}
+
+// CatchProbability is the chance of a catch without a critical capture: all
+// four shake checks have to pass.
+func CatchProbability(a float64) float64 {
+	return math.Pow(ShakeProbability(a), 4)
+}
+
+// criticalMultiplier scales the critical capture chance by the number of
+// species the player has caught, as in Gen V.
+func criticalMultiplier(speciesCaught int) float64 {
+	switch {
+	case speciesCaught > 600:
+		return 2.5
+	case speciesCaught > 450:
+		return 2
+	case speciesCaught > 300:
+		return 1.5
+	case speciesCaught > 150:
+		return 1
+	case speciesCaught > 30:
+		return 0.5
+	}
+	return 0
+}
+
+// CriticalProbability is the chance a throw is a critical capture, which only
+// needs a single shake check to pass.
+func CriticalProbability(a float64, speciesCaught int) float64 {
+	return math.Floor(math.Min(a, 255)*criticalMultiplier(speciesCaught)/6) / 256
+}
+
+// CatchResult is the outcome of a throw. Shakes is how many times the ball
+// wobbled before the Pokémon broke free or was caught.
+type CatchResult struct {
+	Caught   bool
+	Critical bool
+	Shakes   int
+}
+
+// Throw rolls a throw with catch value a using rng. A critical capture makes
+// one shake check; otherwise four are made and the ball wobbles once for each
+// of the first three that pass.
+func Throw(rng *rand.Rand, a float64, speciesCaught int) CatchResult {
+	if a >= 255 {
+		return CatchResult{Caught: true, Shakes: 3}
+	}
+
+	checks := 4
+	var result CatchResult
+	if rng.Float64() < CriticalProbability(a, speciesCaught) {
+		result.Critical = true
+		checks = 1
+	}
+
+	shake := ShakeProbability(a)
+	for i := 0; i < checks; i++ {
+		if rng.Float64() >= shake {
+			return result
+		}
+		if result.Shakes < 3 {
+			result.Shakes++
+		}
+	}
+	result.Caught = true
+	return result
+}
@@ -0,0 +1,103 @@
+package game
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestCatchProbability(t *testing.T) {
+	// Without a critical capture the four shake checks work out to about a/255
+	cases := []struct {
+		name        string
+		captureRate int
+		hpFraction  float64
+		ball        string
+		status      string
+		expected    float64
+	}{
+		{name: "pikachu full hp", captureRate: 190, hpFraction: 1, ball: "poke-ball", expected: 0.2483},
+		{name: "pidgey full hp", captureRate: 255, hpFraction: 1, ball: "poke-ball", expected: 0.3333},
+		{name: "starter full hp", captureRate: 45, hpFraction: 1, ball: "poke-ball", expected: 0.0588},
+		{name: "starter fainting", captureRate: 45, hpFraction: 0, ball: "poke-ball", expected: 0.1765},
+		{name: "starter asleep fainting", captureRate: 45, hpFraction: 0, ball: "poke-ball", status: "sleep", expected: 0.3529},
+		{name: "pikachu half hp great ball", captureRate: 190, hpFraction: 0.5, ball: "great-ball", expected: 0.7450},
+		{name: "legendary ultra ball", captureRate: 3, hpFraction: 1, ball: "ultra-ball", expected: 0.0078},
+		{name: "legendary paralyzed", captureRate: 3, hpFraction: 0, ball: "ultra-ball", status: "paralysis", expected: 0.0353},
+		{name: "pidgey fainting asleep ultra ball", captureRate: 255, hpFraction: 0, ball: "ultra-ball", status: "sleep", expected: 1},
+		{name: "legendary master ball", captureRate: 3, hpFraction: 1, ball: "master-ball", expected: 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ball, err := LookupBall(c.ball)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bonus, err := StatusBonus(c.status)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual := CatchProbability(CatchValue(c.captureRate, c.hpFraction, ball.Multiplier, bonus))
+			if math.Abs(actual-c.expected) > 0.0005 {
+				t.Errorf("Expected %.4f, got %.4f", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCriticalProbability(t *testing.T) {
+	cases := []struct {
+		a             float64
+		speciesCaught int
+		expected      float64
+	}{
+		{a: 100, speciesCaught: 30, expected: 0},
+		{a: 100, speciesCaught: 31, expected: 8.0 / 256},
+		{a: 100, speciesCaught: 151, expected: 16.0 / 256},
+		{a: 300, speciesCaught: 601, expected: 106.0 / 256},
+	}
+
+	for _, c := range cases {
+		actual := CriticalProbability(c.a, c.speciesCaught)
+		if actual != c.expected {
+			t.Errorf("CriticalProbability(%v, %d): expected %v, got %v", c.a, c.speciesCaught, c.expected, actual)
+		}
+	}
+}
+
+func TestUnknownBallAndStatus(t *testing.T) {
+	if _, err := LookupBall("beast-ball"); err == nil {
+		t.Error("Expected an error for an unknown ball")
+	}
+	if _, err := StatusBonus("confused"); err == nil {
+		t.Error("Expected an error for an unknown status")
+	}
+}
+
+func TestThrowIsReproducible(t *testing.T) {
+	roll := func(seed int64) []CatchResult {
+		rng := rand.New(rand.NewSource(seed))
+		results := make([]CatchResult, 20)
+		for i := range results {
+			results[i] = Throw(rng, 60, 200)
+		}
+		return results
+	}
+
+	first, second := roll(42), roll(42)
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("Throw %d differs with the same seed: %+v vs %+v", i, first[i], second[i])
+		}
+	}
+}
+
+func TestThrowMasterBall(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	result := Throw(rng, CatchValue(3, 1, Balls["master-ball"].Multiplier, 1), 0)
+	if !result.Caught {
+		t.Errorf("Expected the Master Ball to always catch, got %+v", result)
+	}
+}
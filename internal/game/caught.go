@@ -0,0 +1,91 @@
+package game
+
+import (
+	"time"
+
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// DefaultLevel is the level of a Pokémon caught without a wild encounter
+// telling its level.
+const DefaultLevel = 5
+
+// CaughtPokemon is the record kept for a Pokémon the player owns. Each catch
+// is a separate individual with its own ID, so several of the same species can
+// be owned. It holds only what identifies this individual and a few cached
+// values; everything else is looked up from PokeAPI by Name when needed.
+type CaughtPokemon struct {
+	ID        int       `json:"id"`
+	SpeciesID int       `json:"species_id"`
+	Species   string    `json:"species"`
+	Name      string    `json:"name"`
+	Nickname  string    `json:"nickname,omitempty"`
+	Tags      []string  `json:"tags,omitempty"`
+	Favorite  bool      `json:"favorite,omitempty"`
+	CaughtAt  time.Time `json:"caught_at"`
+	Location  string    `json:"location,omitempty"`
+	Level     int       `json:"level"`
+	Exp       int       `json:"exp"`
+	IVs       Stats     `json:"ivs"`
+	Nature    string    `json:"nature"`
+	Types     []string  `json:"types"`
+	Stats     Stats     `json:"stats"`
+}
+
+// NewCaughtPokemon creates the record for a freshly caught Pokémon, computing
+// its stats from its base stats, IVs, level and nature, and starting its
+// experience at the minimum for its level on the species' growth curve.
+func NewCaughtPokemon(pokemon *pokeapi.Pokemon, level int, ivs Stats, nature *pokeapi.Nature, curve GrowthCurve, location string) *CaughtPokemon {
+	caught := &CaughtPokemon{
+		SpeciesID: pokeapi.IDFromURL(pokemon.Species.URL),
+		Species:   pokemon.Species.Name,
+		Name:      pokemon.Name,
+		CaughtAt:  time.Now(),
+		Location:  location,
+		Level:     level,
+		IVs:       ivs,
+		Nature:    nature.Name,
+	}
+	if caught.SpeciesID == 0 {
+		caught.SpeciesID = pokemon.ID
+	}
+	for _, t := range pokemon.Types {
+		caught.Types = append(caught.Types, t.Type.Name)
+	}
+
+	if len(curve) > 0 {
+		caught.Exp = curve.ExpForLevel(level)
+	}
+	caught.Recalculate(pokemon, nature)
+	return caught
+}
+
+// natureStats returns the stats a nature raises and lowers, both empty for a
+// neutral nature.
+func natureStats(nature *pokeapi.Nature) (increased, decreased string) {
+	if nature.IncreasedStat != nil && nature.DecreasedStat != nil {
+		return nature.IncreasedStat.Name, nature.DecreasedStat.Name
+	}
+	return "", ""
+}
+
+// DisplayName returns the nickname if the Pokémon has one, otherwise its name.
+func (c *CaughtPokemon) DisplayName() string {
+	if c.Nickname != "" {
+		return c.Nickname
+	}
+	return c.Name
+}
+
+// ToggleTag adds label to the Pokémon's tags, or removes it if already there.
+// It reports whether the tag is now set.
+func (c *CaughtPokemon) ToggleTag(label string) bool {
+	for i, tag := range c.Tags {
+		if tag == label {
+			c.Tags = append(c.Tags[:i], c.Tags[i+1:]...)
+			return false
+		}
+	}
+	c.Tags = append(c.Tags, label)
+	return true
+}
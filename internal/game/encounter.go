@@ -0,0 +1,44 @@
+package game
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// EncounterSlot is one way a wild Pokémon can appear in an area: the chance
+// out of the method's total and the levels it appears at.
+type EncounterSlot struct {
+	Pokemon  string
+	Chance   int
+	MinLevel int
+	MaxLevel int
+}
+
+// RollEncounter picks a slot with probability proportional to its chance and
+// a level between its minimum and maximum, inclusive. Slots without a
+// positive chance are never picked.
+func RollEncounter(rng *rand.Rand, slots []EncounterSlot) (EncounterSlot, int, error) {
+	total := 0
+	for _, slot := range slots {
+		total += max(slot.Chance, 0)
+	}
+	if total <= 0 {
+		return EncounterSlot{}, 0, fmt.Errorf("no Pokemon to encounter")
+	}
+
+	roll := rng.Intn(total)
+	for _, slot := range slots {
+		if slot.Chance <= 0 {
+			continue
+		}
+		if roll < slot.Chance {
+			level := slot.MinLevel
+			if slot.MaxLevel > slot.MinLevel {
+				level += rng.Intn(slot.MaxLevel - slot.MinLevel + 1)
+			}
+			return slot, level, nil
+		}
+		roll -= slot.Chance
+	}
+	return EncounterSlot{}, 0, fmt.Errorf("no Pokemon to encounter")
+}
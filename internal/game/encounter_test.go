@@ -0,0 +1,69 @@
+package game
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRollEncounter(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	slots := []EncounterSlot{
+		{Pokemon: "pidgey", Chance: 70, MinLevel: 2, MaxLevel: 4},
+		{Pokemon: "rattata", Chance: 30, MinLevel: 3, MaxLevel: 3},
+	}
+
+	const rolls = 10000
+	counts := map[string]int{}
+	for i := 0; i < rolls; i++ {
+		slot, level, err := RollEncounter(rng, slots)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if level < slot.MinLevel || level > slot.MaxLevel {
+			t.Fatalf("Level %d outside %d-%d for %s", level, slot.MinLevel, slot.MaxLevel, slot.Pokemon)
+		}
+		counts[slot.Pokemon]++
+	}
+
+	if share := float64(counts["pidgey"]) / rolls; share < 0.68 || share > 0.72 {
+		t.Errorf("Expected pidgey about 70%% of the time, got %.1f%%", share*100)
+	}
+
+	if _, _, err := RollEncounter(rng, nil); err == nil {
+		t.Error("Expected an error with no slots")
+	}
+}
+
+func TestRollEncounterBadChances(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	if _, _, err := RollEncounter(rng, []EncounterSlot{{Pokemon: "pidgey"}, {Pokemon: "rattata", Chance: -5}}); err == nil {
+		t.Error("Expected an error when no slot has a positive chance")
+	}
+
+	slots := []EncounterSlot{
+		{Pokemon: "missingno", Chance: -50, MinLevel: 80, MaxLevel: 80},
+		{Pokemon: "pidgey", Chance: 10, MinLevel: 2, MaxLevel: 2},
+	}
+	for i := 0; i < 100; i++ {
+		slot, _, err := RollEncounter(rng, slots)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if slot.Pokemon != "pidgey" {
+			t.Fatalf("Expected only pidgey, got %s", slot.Pokemon)
+		}
+	}
+}
+
+func TestDamage(t *testing.T) {
+	// level 10, attack 20 against defense 20: (6*40*20/20)/50+2 = 6 before the
+	// random factor, so 5 or 6 after it
+	attacker := Stats{Attack: 20, SpecialAttack: 10}
+	defender := Stats{Defense: 20, SpecialDefense: 5}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		if damage := Damage(rng, 10, attacker, defender); damage < 5 || damage > 6 {
+			t.Fatalf("Expected 5-6 damage, got %d", damage)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package game
+
+import "github.com/markcromwell/pokedexcli/internal/pokeapi"
+
+// MaxLevel is the highest level a Pokémon can reach.
+const MaxLevel = 100
+
+// GrowthCurve holds the total experience needed to reach each level, starting
+// with level 1 at index 0.
+type GrowthCurve []int
+
+// NewGrowthCurve builds the curve from a PokeAPI growth rate.
+func NewGrowthCurve(rate *pokeapi.GrowthRate) GrowthCurve {
+	curve := make(GrowthCurve, MaxLevel)
+	for _, l := range rate.Levels {
+		if l.Level >= 1 && l.Level <= MaxLevel {
+			curve[l.Level-1] = l.Experience
+		}
+	}
+	return curve
+}
+
+// ExpForLevel returns the total experience needed to reach level.
+func (g GrowthCurve) ExpForLevel(level int) int {
+	level = max(1, min(level, len(g)))
+	return g[level-1]
+}
+
+// LevelForExp returns the level reached with exp total experience.
+func (g GrowthCurve) LevelForExp(exp int) int {
+	level := 1
+	for level < len(g) && g[level] <= exp {
+		level++
+	}
+	return level
+}
+
+// ExpYield is the experience earned for defeating a wild Pokémon with the
+// given base experience at the given level.
+func ExpYield(baseExperience, level int) int {
+	return max(baseExperience*level/7, 1)
+}
+
+// GainExp adds exp to the Pokémon's experience and raises its level to match,
+// up to MaxLevel. Saves migrated from before experience was tracked have none,
+// so their experience is first brought up to the minimum for their level.
+// Without a curve the experience is only recorded, and the level catches up on
+// the next gain with one. It returns the level the Pokémon was at before;
+// stats are not recalculated.
+func (c *CaughtPokemon) GainExp(exp int, curve GrowthCurve) int {
+	from := c.Level
+	if len(curve) == 0 {
+		c.Exp += exp
+		return from
+	}
+	c.Exp = max(c.Exp, curve.ExpForLevel(c.Level))
+	c.Exp = min(c.Exp+exp, curve.ExpForLevel(MaxLevel))
+	c.Level = max(c.Level, curve.LevelForExp(c.Exp))
+	return from
+}
+
+// Recalculate recomputes the Pokémon's stats for its current level from its
+// species' base stats and its IVs and nature.
+func (c *CaughtPokemon) Recalculate(pokemon *pokeapi.Pokemon, nature *pokeapi.Nature) {
+	increased, decreased := natureStats(nature)
+	c.Stats = CalculateStats(BaseStats(pokemon.Stats), c.IVs, c.Level, increased, decreased)
+}
@@ -0,0 +1,99 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// mediumFast builds the medium-fast growth rate, where level n needs n^3 experience.
+func mediumFast() GrowthCurve {
+	var rate pokeapi.GrowthRate
+	for level := 1; level <= MaxLevel; level++ {
+		rate.Levels = append(rate.Levels, pokeapi.GrowthRateExperienceLevel{Level: level, Experience: level * level * level})
+	}
+	return NewGrowthCurve(&rate)
+}
+
+func TestLevelForExp(t *testing.T) {
+	curve := mediumFast()
+	cases := []struct {
+		exp      int
+		expected int
+	}{
+		{exp: 0, expected: 1},
+		{exp: 7, expected: 1},
+		{exp: 8, expected: 2},
+		{exp: 124, expected: 4},
+		{exp: 125, expected: 5},
+		{exp: 2000000, expected: 100},
+	}
+
+	for _, c := range cases {
+		if actual := curve.LevelForExp(c.exp); actual != c.expected {
+			t.Errorf("LevelForExp(%d): expected %d, got %d", c.exp, c.expected, actual)
+		}
+	}
+}
+
+func TestGainExp(t *testing.T) {
+	curve := mediumFast()
+
+	cases := []struct {
+		name          string
+		pokemon       CaughtPokemon
+		gain          int
+		expectedLevel int
+		expectedExp   int
+	}{
+		{name: "no level up", pokemon: CaughtPokemon{Level: 5, Exp: 125}, gain: 50, expectedLevel: 5, expectedExp: 175},
+		{name: "level up", pokemon: CaughtPokemon{Level: 5, Exp: 200}, gain: 16, expectedLevel: 6, expectedExp: 216},
+		{name: "several levels", pokemon: CaughtPokemon{Level: 5, Exp: 125}, gain: 400, expectedLevel: 8, expectedExp: 525},
+		{name: "migrated save without exp", pokemon: CaughtPokemon{Level: 10}, gain: 331, expectedLevel: 11, expectedExp: 1331},
+		{name: "max level", pokemon: CaughtPokemon{Level: 99, Exp: 990000}, gain: 50000, expectedLevel: 100, expectedExp: 1000000},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			pokemon := c.pokemon
+			from := pokemon.GainExp(c.gain, curve)
+			if from != c.pokemon.Level {
+				t.Errorf("Expected to start at level %d, got %d", c.pokemon.Level, from)
+			}
+			if pokemon.Level != c.expectedLevel || pokemon.Exp != c.expectedExp {
+				t.Errorf("Expected level %d with %d EXP, got level %d with %d EXP", c.expectedLevel, c.expectedExp, pokemon.Level, pokemon.Exp)
+			}
+		})
+	}
+}
+
+func TestGainExpWithoutCurve(t *testing.T) {
+	pokemon := CaughtPokemon{Level: 5, Exp: 200}
+	if from := pokemon.GainExp(16, nil); from != 5 {
+		t.Errorf("Expected to start at level 5, got %d", from)
+	}
+	if pokemon.Level != 5 || pokemon.Exp != 216 {
+		t.Errorf("Expected the EXP to be recorded at level 5, got level %d with %d EXP", pokemon.Level, pokemon.Exp)
+	}
+
+	// the level catches up once a curve is available
+	pokemon.GainExp(0, mediumFast())
+	if pokemon.Level != 6 {
+		t.Errorf("Expected level 6 after gaining with a curve, got %d", pokemon.Level)
+	}
+}
+
+func TestNewCaughtPokemonStartingExp(t *testing.T) {
+	pokemon := &pokeapi.Pokemon{Name: "pidgey"}
+	caught := NewCaughtPokemon(pokemon, 5, Stats{}, &pokeapi.Nature{Name: "hardy"}, mediumFast(), "route-1")
+	if caught.Exp != 125 {
+		t.Errorf("Expected a level 5 Pokemon to start with 125 EXP, got %d", caught.Exp)
+	}
+}
+
+func TestExpYield(t *testing.T) {
+	// a level 10 pidgey, base experience 50
+	if actual := ExpYield(50, 10); actual != 71 {
+		t.Errorf("Expected 71 EXP, got %d", actual)
+	}
+}
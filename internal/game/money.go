@@ -0,0 +1,47 @@
+package game
+
+// StartingMoney is the money a new game starts with.
+const StartingMoney = 3000
+
+// ShopStock lists the items sold in the Poke Mart by PokeAPI item name, in
+// display order. Prices come from each item's PokeAPI cost.
+var ShopStock = []string{
+	"poke-ball",
+	"great-ball",
+	"ultra-ball",
+	"potion",
+	"super-potion",
+	"hyper-potion",
+	"antidote",
+	"paralyze-heal",
+	"awakening",
+	"burn-heal",
+	"ice-heal",
+	"revive",
+}
+
+// InStock reports whether the Poke Mart sells an item.
+func InStock(item string) bool {
+	for _, stocked := range ShopStock {
+		if stocked == item {
+			return true
+		}
+	}
+	return false
+}
+
+// SellPrice is what the Poke Mart pays for an item it sells for cost: half
+// the price, rounded down.
+func SellPrice(cost int) int {
+	return cost / 2
+}
+
+// CatchReward is the money earned for catching a Pokémon at the given level.
+func CatchReward(level int) int {
+	return 20 * level
+}
+
+// BattleReward is the money earned for defeating a wild Pokémon at the given level.
+func BattleReward(level int) int {
+	return 10 * level
+}
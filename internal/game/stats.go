@@ -0,0 +1,103 @@
+package game
+
+import (
+	"math/rand"
+
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// MaxIV is the highest individual value a stat can have.
+const MaxIV = 31
+
+// Stats holds a value for each of the six stats. It is used for base stats,
+// individual values (IVs) and computed stats alike.
+type Stats struct {
+	HP             int `json:"hp"`
+	Attack         int `json:"attack"`
+	Defense        int `json:"defense"`
+	SpecialAttack  int `json:"special-attack"`
+	SpecialDefense int `json:"special-defense"`
+	Speed          int `json:"speed"`
+}
+
+// StatNames lists the PokeAPI names of the six stats, in display order.
+var StatNames = []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"}
+
+// field returns a pointer to the stat with the given PokeAPI name, or nil.
+func (s *Stats) field(name string) *int {
+	switch name {
+	case "hp":
+		return &s.HP
+	case "attack":
+		return &s.Attack
+	case "defense":
+		return &s.Defense
+	case "special-attack":
+		return &s.SpecialAttack
+	case "special-defense":
+		return &s.SpecialDefense
+	case "speed":
+		return &s.Speed
+	}
+	return nil
+}
+
+// Get returns the stat with the given PokeAPI name, or 0 for an unknown name.
+func (s Stats) Get(name string) int {
+	if f := s.field(name); f != nil {
+		return *f
+	}
+	return 0
+}
+
+// Set sets the stat with the given PokeAPI name; unknown names are ignored.
+func (s *Stats) Set(name string, value int) {
+	if f := s.field(name); f != nil {
+		*f = value
+	}
+}
+
+// BaseStats collects a Pokémon's base stats from the PokeAPI stats list.
+func BaseStats(stats []pokeapi.PokemonStat) Stats {
+	var base Stats
+	for _, stat := range stats {
+		base.Set(stat.Stat.Name, stat.BaseStat)
+	}
+	return base
+}
+
+// RandomIVs rolls an individual value between 0 and MaxIV for every stat.
+func RandomIVs(rng *rand.Rand) Stats {
+	var ivs Stats
+	for _, name := range StatNames {
+		ivs.Set(name, rng.Intn(MaxIV+1))
+	}
+	return ivs
+}
+
+// CalculateStats computes a Pokémon's stats at the given level using the
+// Gen III formula, without effort values. The nature raises the increased stat
+// by 10% and lowers the decreased one by 10%; neutral natures pass empty names
+// or the same name twice.
+func CalculateStats(base, ivs Stats, level int, increased, decreased string) Stats {
+	var stats Stats
+	for _, name := range StatNames {
+		core := (2*base.Get(name) + ivs.Get(name)) * level / 100
+		if name == "hp" {
+			stats.HP = core + level + 10
+			continue
+		}
+
+		value := core + 5
+		if increased != decreased {
+			switch name {
+			case increased:
+				value = value * 110 / 100
+			case decreased:
+				value = value * 90 / 100
+			}
+		}
+		stats.Set(name, value)
+	}
+	return stats
+}
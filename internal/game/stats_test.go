@@ -0,0 +1,42 @@
+package game
+
+import "testing"
+
+func TestCalculateStats(t *testing.T) {
+	// Garchomp at level 78 with no EVs, values worked out by hand from the formula
+	base := Stats{HP: 108, Attack: 130, Defense: 95, SpecialAttack: 80, SpecialDefense: 85, Speed: 102}
+	ivs := Stats{HP: 24, Attack: 12, Defense: 30, SpecialAttack: 16, SpecialDefense: 23, Speed: 5}
+
+	cases := []struct {
+		name      string
+		increased string
+		decreased string
+		expected  Stats
+	}{
+		{
+			name:     "neutral",
+			expected: Stats{HP: 275, Attack: 217, Defense: 176, SpecialAttack: 142, SpecialDefense: 155, Speed: 168},
+		},
+		{
+			name:      "adamant",
+			increased: "attack",
+			decreased: "special-attack",
+			expected:  Stats{HP: 275, Attack: 238, Defense: 176, SpecialAttack: 127, SpecialDefense: 155, Speed: 168},
+		},
+		{
+			name:      "hardy",
+			increased: "attack",
+			decreased: "attack",
+			expected:  Stats{HP: 275, Attack: 217, Defense: 176, SpecialAttack: 142, SpecialDefense: 155, Speed: 168},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := CalculateStats(base, ivs, 78, c.increased, c.decreased)
+			if actual != c.expected {
+				t.Errorf("Expected %+v, got %+v", c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package game
+
+import "fmt"
+
+// PartySize is the most Pokémon the player can carry.
+const PartySize = 6
+
+// BoxCount is the number of PC boxes.
+const BoxCount = 8
+
+// BoxSize is the most Pokémon a PC box can hold.
+const BoxSize = 30
+
+// Storage tracks where each owned Pokémon is kept, by instance ID: in the
+// party or in one of the numbered PC boxes.
+type Storage struct {
+	Party []int   `json:"party"`
+	Boxes [][]int `json:"boxes"`
+}
+
+// NewStorage returns an empty party and empty PC boxes.
+func NewStorage() *Storage {
+	s := &Storage{Party: []int{}}
+	s.Reconcile(nil)
+	return s
+}
+
+// Locate returns where a Pokémon is kept: box 0 for the party, otherwise the
+// box number starting at 1.
+func (s *Storage) Locate(id int) (box int, found bool) {
+	if indexOf(s.Party, id) >= 0 {
+		return 0, true
+	}
+	for i, b := range s.Boxes {
+		if indexOf(b, id) >= 0 {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+// Place puts a newly caught Pokémon in the party, or in the first PC box with
+// room once the party is full. It returns where it went, as Locate does.
+func (s *Storage) Place(id int) (int, error) {
+	if len(s.Party) < PartySize {
+		s.Party = append(s.Party, id)
+		return 0, nil
+	}
+	return s.toBox(id)
+}
+
+// HasRoom reports whether another Pokémon can be placed, in the party or a
+// PC box.
+func (s *Storage) HasRoom() bool {
+	if len(s.Party) < PartySize {
+		return true
+	}
+	for _, b := range s.Boxes {
+		if len(b) < BoxSize {
+			return true
+		}
+	}
+	return false
+}
+
+// toBox puts a Pokémon in the first PC box with room.
+func (s *Storage) toBox(id int) (int, error) {
+	for i, b := range s.Boxes {
+		if len(b) < BoxSize {
+			s.Boxes[i] = append(b, id)
+			return i + 1, nil
+		}
+	}
+	return 0, fmt.Errorf("all PC boxes are full")
+}
+
+// Deposit moves a Pokémon from the party to the first PC box with room.
+// The last Pokémon in the party can't be deposited.
+func (s *Storage) Deposit(id int) (int, error) {
+	i := indexOf(s.Party, id)
+	if i < 0 {
+		return 0, fmt.Errorf("Pokemon %d is not in your party", id)
+	}
+	if len(s.Party) == 1 {
+		return 0, fmt.Errorf("you can't deposit your last Pokemon")
+	}
+
+	box, err := s.toBox(id)
+	if err != nil {
+		return 0, err
+	}
+	s.Party = append(s.Party[:i], s.Party[i+1:]...)
+	return box, nil
+}
+
+// Withdraw moves a Pokémon from its PC box to the party.
+func (s *Storage) Withdraw(id int) error {
+	box, found := s.Locate(id)
+	if !found || box == 0 {
+		return fmt.Errorf("Pokemon %d is not in a PC box", id)
+	}
+	if len(s.Party) >= PartySize {
+		return fmt.Errorf("your party is full, deposit a Pokemon first")
+	}
+
+	s.Boxes[box-1] = remove(s.Boxes[box-1], id)
+	s.Party = append(s.Party, id)
+	return nil
+}
+
+// Swap exchanges the places of two Pokémon, wherever they are kept. It can
+// reorder the party or trade a party member for one in a box.
+func (s *Storage) Swap(a, b int) error {
+	pa, err := s.slot(a)
+	if err != nil {
+		return err
+	}
+	pb, err := s.slot(b)
+	if err != nil {
+		return err
+	}
+	*pa, *pb = *pb, *pa
+	return nil
+}
+
+// slot returns a pointer to the position holding a Pokémon.
+func (s *Storage) slot(id int) (*int, error) {
+	if i := indexOf(s.Party, id); i >= 0 {
+		return &s.Party[i], nil
+	}
+	for _, b := range s.Boxes {
+		if i := indexOf(b, id); i >= 0 {
+			return &b[i], nil
+		}
+	}
+	return nil, fmt.Errorf("Pokemon %d is not in your party or PC", id)
+}
+
+// Remove takes a Pokémon out of storage, reporting whether it was there.
+func (s *Storage) Remove(id int) bool {
+	box, found := s.Locate(id)
+	if !found {
+		return false
+	}
+	if box == 0 {
+		s.Party = remove(s.Party, id)
+	} else {
+		s.Boxes[box-1] = remove(s.Boxes[box-1], id)
+	}
+	return true
+}
+
+// Reconcile makes storage agree with the set of owned IDs: IDs no longer
+// owned are dropped and owned Pokémon missing from storage are placed.
+func (s *Storage) Reconcile(owned []int) {
+	for len(s.Boxes) < BoxCount {
+		s.Boxes = append(s.Boxes, []int{})
+	}
+	ownedSet := make(map[int]bool, len(owned))
+	for _, id := range owned {
+		ownedSet[id] = true
+	}
+
+	keep := func(ids []int) []int {
+		kept := ids[:0]
+		for _, id := range ids {
+			if ownedSet[id] {
+				kept = append(kept, id)
+			}
+		}
+		return kept
+	}
+	s.Party = keep(s.Party)
+	for i := range s.Boxes {
+		s.Boxes[i] = keep(s.Boxes[i])
+	}
+
+	for _, id := range owned {
+		if _, found := s.Locate(id); !found {
+			s.Place(id)
+		}
+	}
+}
+
+// indexOf returns the position of id in ids, or -1.
+func indexOf(ids []int, id int) int {
+	for i, v := range ids {
+		if v == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// remove returns ids without id.
+func remove(ids []int, id int) []int {
+	if i := indexOf(ids, id); i >= 0 {
+		return append(ids[:i], ids[i+1:]...)
+	}
+	return ids
+}
@@ -0,0 +1,87 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStoragePlace(t *testing.T) {
+	s := NewStorage()
+	for id := 1; id <= PartySize+2; id++ {
+		box, err := s.Place(id)
+		if err != nil {
+			t.Fatalf("Expected no error placing %d, got: %v", id, err)
+		}
+		expected := 0
+		if id > PartySize {
+			expected = 1
+		}
+		if box != expected {
+			t.Errorf("Expected %d in box %d, got %d", id, expected, box)
+		}
+	}
+
+	if !reflect.DeepEqual(s.Party, []int{1, 2, 3, 4, 5, 6}) || !reflect.DeepEqual(s.Boxes[0], []int{7, 8}) {
+		t.Errorf("Unexpected storage: party %v, box 1 %v", s.Party, s.Boxes[0])
+	}
+}
+
+func TestStorageDepositWithdrawSwap(t *testing.T) {
+	s := NewStorage()
+	for id := 1; id <= 7; id++ {
+		s.Place(id)
+	}
+
+	if err := s.Withdraw(7); err == nil {
+		t.Error("Expected an error withdrawing into a full party")
+	}
+	if box, err := s.Deposit(2); err != nil || box != 1 {
+		t.Fatalf("Expected 2 deposited in box 1, got box %d, err %v", box, err)
+	}
+	if err := s.Withdraw(7); err != nil {
+		t.Fatalf("Expected no error withdrawing 7, got: %v", err)
+	}
+	if !reflect.DeepEqual(s.Party, []int{1, 3, 4, 5, 6, 7}) || !reflect.DeepEqual(s.Boxes[0], []int{2}) {
+		t.Errorf("Unexpected storage after deposit/withdraw: party %v, box 1 %v", s.Party, s.Boxes[0])
+	}
+
+	if err := s.Swap(1, 2); err != nil {
+		t.Fatalf("Expected no error swapping, got: %v", err)
+	}
+	if s.Party[0] != 2 || s.Boxes[0][0] != 1 {
+		t.Errorf("Expected 1 and 2 swapped, got party %v, box 1 %v", s.Party, s.Boxes[0])
+	}
+	if err := s.Swap(1, 99); err == nil {
+		t.Error("Expected an error swapping with a Pokemon not in storage")
+	}
+}
+
+func TestStorageLastPartyMember(t *testing.T) {
+	s := NewStorage()
+	s.Place(1)
+	if _, err := s.Deposit(1); err == nil {
+		t.Error("Expected an error depositing the last party member")
+	}
+}
+
+func TestStorageHasRoom(t *testing.T) {
+	s := NewStorage()
+	for id := 1; id < PartySize+BoxCount*BoxSize; id++ {
+		s.Place(id)
+	}
+	if !s.HasRoom() {
+		t.Fatal("Expected room for one more Pokemon")
+	}
+	s.Place(PartySize + BoxCount*BoxSize)
+	if s.HasRoom() {
+		t.Error("Expected no room with the party and every box full")
+	}
+}
+
+func TestStorageReconcile(t *testing.T) {
+	s := &Storage{Party: []int{1, 9}}
+	s.Reconcile([]int{1, 2, 3})
+	if !reflect.DeepEqual(s.Party, []int{1, 2, 3}) || len(s.Boxes) != BoxCount {
+		t.Errorf("Unexpected storage after reconcile: party %v, %d boxes", s.Party, len(s.Boxes))
+	}
+}
@@ -0,0 +1,71 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+)
+
+// BatchResult is the outcome of fetching one item of a batch.
+type BatchResult[T any] struct {
+	Name  string
+	Value T
+	Err   error
+}
+
+// Batch calls fetch for every name using at most concurrency workers.
+// Results are returned in the same order as names, each with its own error;
+// items not started before ctx is done fail with the context's error.
+func Batch[T any](ctx context.Context, names []string, concurrency int, fetch func(ctx context.Context, name string) (T, error)) []BatchResult[T] {
+	results := make([]BatchResult[T], len(names))
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(concurrency, len(names)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i].Value, results[i].Err = fetch(ctx, names[i])
+			}
+		}()
+	}
+
+	for i, name := range names {
+		results[i].Name = name
+		if ctx.Err() != nil {
+			results[i].Err = ctx.Err()
+			continue
+		}
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// GetResourceBatch fetches many resources of one type (e.g. "pokemon", "move")
+// by name or ID concurrently and parses each response with parse.
+// Requests go through the cache and the rate limiter like any other.
+func GetResourceBatch[T any](ctx context.Context, resource string, names []string, concurrency int, parse func([]byte) (T, error)) []BatchResult[T] {
+	return Batch(ctx, names, concurrency, func(ctx context.Context, name string) (T, error) {
+		body, err := GetContext(ctx, ResourceURL(resource, name))
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		return parse(body)
+	})
+}
+
+// GetPokemonBatch fetches many Pokémon by name or ID concurrently, preserving order.
+func GetPokemonBatch(ctx context.Context, names []string, concurrency int) []BatchResult[*Pokemon] {
+	return GetResourceBatch(ctx, "pokemon", names, concurrency, ParsePokemon)
+}
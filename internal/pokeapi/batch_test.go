@@ -0,0 +1,67 @@
+package pokeapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBatch(t *testing.T) {
+	names := []string{"bulbasaur", "missingno", "charmander", "squirtle", "pikachu"}
+	var running, peak atomic.Int32
+
+	results := Batch(context.Background(), names, 2, func(ctx context.Context, name string) (string, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		if name == "missingno" {
+			return "", fmt.Errorf("not found")
+		}
+		return strings.ToUpper(name), nil
+	})
+
+	if len(results) != len(names) {
+		t.Fatalf("Expected %d results, got %d", len(names), len(results))
+	}
+	for i, r := range results {
+		if r.Name != names[i] {
+			t.Errorf("Result %d: expected name %s, got %s", i, names[i], r.Name)
+		}
+		if names[i] == "missingno" {
+			if r.Err == nil {
+				t.Errorf("Expected an error for %s", r.Name)
+			}
+			continue
+		}
+		if r.Err != nil || r.Value != strings.ToUpper(names[i]) {
+			t.Errorf("Result %d: expected %s, got %q (err %v)", i, strings.ToUpper(names[i]), r.Value, r.Err)
+		}
+	}
+	if peak.Load() > 2 {
+		t.Errorf("Expected at most 2 concurrent fetches, saw %d", peak.Load())
+	}
+}
+
+func TestBatchCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := Batch(ctx, []string{"a", "b", "c"}, 2, func(ctx context.Context, name string) (int, error) {
+		return 0, ctx.Err()
+	})
+	for _, r := range results {
+		if r.Err == nil {
+			t.Errorf("Expected an error for %s after cancellation", r.Name)
+		}
+	}
+}
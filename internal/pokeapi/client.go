@@ -1,6 +1,8 @@
 package pokeapi
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -20,6 +22,13 @@ const PokeAPIBaseURLMap = PokeAPIBaseURL + "location-area/"
 
 // Get makes a GET request to the given full URL and returns the response body as bytes.
 func Get(url string) ([]byte, error) {
+	return GetContext(context.Background(), url)
+}
+
+// GetContext is like Get but the request is cancelled when ctx is done.
+// Responses are served from the cache when possible; otherwise the request
+// waits its turn with the rate limiter.
+func GetContext(ctx context.Context, url string) ([]byte, error) {
 
 	data, found := cache.Get(url)
 
@@ -27,22 +36,72 @@ func Get(url string) ([]byte, error) {
 		return data, nil
 	}
 
-	resp, err := http.Get(url)
+	resp, err := fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
 
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, err
 	}
+
+	cache.Add(url, body)
+	return body, nil
+}
+
+// GetStream is like GetContext but hands the response body to decode as it
+// arrives instead of reading it all first. The body is still cached, and a
+// cached response is decoded from memory.
+func GetStream(ctx context.Context, url string, decode func(io.Reader) error) error {
+	if data, found := cache.Get(url); found {
+		return decode(bytes.NewReader(data))
+	}
+
+	resp, err := fetch(ctx, url)
+	if err != nil {
+		return err
+	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("PokeAPI request failed: %s", resp.Status)
+	var body bytes.Buffer
+	tee := io.TeeReader(resp.Body, &body)
+	if err := decode(tee); err != nil {
+		return err
+	}
+	// read whatever decode left so the whole body is cached
+	if _, err := io.Copy(io.Discard, tee); err != nil {
+		return err
 	}
 
-	body, err := io.ReadAll(resp.Body)
+	cache.Add(url, body.Bytes())
+	return nil
+}
+
+// fetch waits its turn with the rate limiter and requests url, returning the
+// response if it succeeded. The caller closes the body.
+func fetch(ctx context.Context, url string) (*http.Response, error) {
+	if err := limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
-	return body, nil
+
+	resp, err := http.DefaultClient.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("PokeAPI request failed: %s", resp.Status)
+	}
+	return resp, nil
 }
 
 // GetLocationAreas fetches a batch of location areas from the PokeAPI location-area endpoint and parses the response.
@@ -78,56 +137,28 @@ func ParseLocationData(data []byte) (*LocationData, error) {
 
 // LocationArea represents the structure of a single location area from the PokeAPI.
 type LocationArea struct {
-	ID                   int    `json:"id"`
-	Name                 string `json:"name"`
-	GameIndex            int    `json:"game_index"`
-	EncounterMethodRates []struct {
-		EncounterMethod struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"encounter_method"`
-		VersionDetails []struct {
-			Rate    int `json:"rate"`
-			Version struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"version"`
-		} `json:"version_details"`
-	} `json:"encounter_method_rates"`
-	Location struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"location"`
-	Names []struct {
-		Name     string `json:"name"`
-		Language struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"language"`
-	} `json:"names"`
-	PokemonEncounters []struct {
-		Pokemon struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"pokemon"`
-		VersionDetails []struct {
-			Version struct {
-				Name string `json:"name"`
-				URL  string `json:"url"`
-			} `json:"version"`
-			MaxChance        int `json:"max_chance"`
-			EncounterDetails []struct {
-				MinLevel        int           `json:"min_level"`
-				MaxLevel        int           `json:"max_level"`
-				ConditionValues []interface{} `json:"condition_values"`
-				Chance          int           `json:"chance"`
-				Method          struct {
-					Name string `json:"name"`
-					URL  string `json:"url"`
-				} `json:"method"`
-			} `json:"encounter_details"`
-		} `json:"version_details"`
-	} `json:"pokemon_encounters"`
+	ID                   int                   `json:"id"`
+	Name                 string                `json:"name"`
+	GameIndex            int                   `json:"game_index"`
+	EncounterMethodRates []EncounterMethodRate `json:"encounter_method_rates"`
+	Location             NamedAPIResource      `json:"location"`
+	Names                []Name                `json:"names"`
+	PokemonEncounters    []PokemonEncounter    `json:"pokemon_encounters"`
+}
+
+// EncounterMethodRate is the chance of triggering an encounter with a given method, per version.
+type EncounterMethodRate struct {
+	EncounterMethod NamedAPIResource `json:"encounter_method"`
+	VersionDetails  []struct {
+		Rate    int              `json:"rate"`
+		Version NamedAPIResource `json:"version"`
+	} `json:"version_details"`
+}
+
+// PokemonEncounter is a Pokémon that can be encountered in a location area, with per-version details.
+type PokemonEncounter struct {
+	Pokemon        NamedAPIResource         `json:"pokemon"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
 }
 
 // ParseLocationArea parses the JSON response for a single location area into a LocationArea struct.
@@ -142,7 +173,7 @@ func ParseLocationArea(data []byte) (*LocationArea, error) {
 
 // GetLocationArea fetches a single location area by its full URL and parses the response.
 func GetLocationArea(locationName string) (*LocationArea, error) {
-	body, err := Get(PokeAPIBaseURL + "location-area/" + locationName)
+	body, err := Get(ResourceURL("location-area", locationName))
 	if err != nil {
 		return nil, err
 	}
@@ -414,22 +445,9 @@ type Pokemon struct {
 			} `json:"generation-viii"`
 		} `json:"versions"`
 	} `json:"sprites"`
-	Stats []struct {
-		BaseStat int `json:"base_stat"`
-		Effort   int `json:"effort"`
-		Stat     struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"stat"`
-	} `json:"stats"`
-	Types []struct {
-		Slot int `json:"slot"`
-		Type struct {
-			Name string `json:"name"`
-			URL  string `json:"url"`
-		} `json:"type"`
-	} `json:"types"`
-	Weight int `json:"weight"`
+	Stats  []PokemonStat `json:"stats"`
+	Types  []PokemonType `json:"types"`
+	Weight int           `json:"weight"`
 }
 
 // ParsePokemon parses the JSON response for a single Pokémon into a Pokemon struct.
@@ -444,7 +462,7 @@ func ParsePokemon(data []byte) (*Pokemon, error) {
 
 // GetPokemon fetches a single Pokémon by its full URL and parses the response.
 func GetPokemon(pokemanName string) (*Pokemon, error) {
-	body, err := Get(PokeAPIBaseURL + "pokemon/" + pokemanName)
+	body, err := Get(ResourceURL("pokemon", pokemanName))
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,14 @@
 package pokeapi
 
-import "testing"
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+)
 
 func TestGet(t *testing.T) {
 	endpoints := []string{
@@ -24,3 +32,75 @@ func TestGet(t *testing.T) {
 		})
 	}
 }
+
+// roundTripFunc serves requests without the network.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// TestPrefetchedResourceIsCached warms resources by their ResourceURL, as the
+// REPL's prefetcher does, and checks that the getters are then served from the
+// cache instead of making another request.
+func TestPrefetchedResourceIsCached(t *testing.T) {
+	requests := map[string]int{}
+	original := http.DefaultClient.Transport
+	http.DefaultClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests[req.URL.String()]++
+		body := fmt.Sprintf(`{"id": 1, "name": %q}`, path.Base(req.URL.Path))
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: http.Header{}}, nil
+	})
+	defer func() { http.DefaultClient.Transport = original }()
+
+	areaURL := ResourceURL("location-area", "prefetch-test-area")
+	pokemonURL := ResourceURL("pokemon", "prefetch-test-mon")
+	for _, url := range []string{areaURL, pokemonURL} {
+		if _, err := GetContext(context.Background(), url); err != nil {
+			t.Fatalf("Prefetching %s failed: %v", url, err)
+		}
+	}
+
+	if _, err := GetLocationArea("prefetch-test-area"); err != nil {
+		t.Fatalf("GetLocationArea failed: %v", err)
+	}
+	if _, err := GetPokemon("prefetch-test-mon"); err != nil {
+		t.Fatalf("GetPokemon failed: %v", err)
+	}
+
+	for _, url := range []string{areaURL, pokemonURL} {
+		if requests[url] != 1 {
+			t.Errorf("Expected 1 request for %s, got %d", url, requests[url])
+		}
+	}
+}
+
+func TestGetStream(t *testing.T) {
+	requests := 0
+	original := http.DefaultClient.Transport
+	http.DefaultClient.Transport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		body := `{"id": 25, "name": "pikachu", "moves": []}` + "\n"
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: http.Header{}}, nil
+	})
+	defer func() { http.DefaultClient.Transport = original }()
+
+	for range 2 {
+		summary, err := GetPokemonSummary("stream-test-mon")
+		if err != nil {
+			t.Fatalf("GetPokemonSummary failed: %v", err)
+		}
+		if summary.ID != 25 || summary.Name != "pikachu" {
+			t.Errorf("Expected pikachu (#25), got %s (#%d)", summary.Name, summary.ID)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("Expected the streamed response to be cached, got %d requests", requests)
+	}
+
+	// the whole body is cached, not just what the decoder read
+	data, err := Get(ResourceURL("pokemon", "stream-test-mon"))
+	if err != nil || !strings.HasSuffix(string(data), "]}\n") {
+		t.Errorf("Expected the full body to be cached, got %q (err %v)", data, err)
+	}
+}
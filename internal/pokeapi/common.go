@@ -0,0 +1,122 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// NamedAPIResource is the name/URL pair PokeAPI uses to reference other resources.
+type NamedAPIResource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// NamedAPIResourceList is a page of results from a PokeAPI list endpoint.
+type NamedAPIResourceList struct {
+	Count    int                `json:"count"`
+	Next     *string            `json:"next"`
+	Previous *string            `json:"previous"`
+	Results  []NamedAPIResource `json:"results"`
+}
+
+// ParseNamedAPIResourceList parses the JSON response from a PokeAPI list endpoint.
+func ParseNamedAPIResourceList(data []byte) (*NamedAPIResourceList, error) {
+	var list NamedAPIResourceList
+	err := json.Unmarshal(data, &list)
+	if err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// DefaultLanguage is the language PokeAPI names fall back to.
+const DefaultLanguage = "en"
+
+// Name is a resource's name in a single language.
+type Name struct {
+	Name     string           `json:"name"`
+	Language NamedAPIResource `json:"language"`
+}
+
+// FlavorText is a resource's flavor text in a single language, as shown in a given game.
+type FlavorText struct {
+	FlavorText   string           `json:"flavor_text"`
+	Language     NamedAPIResource `json:"language"`
+	Version      NamedAPIResource `json:"version"`
+	VersionGroup NamedAPIResource `json:"version_group"`
+}
+
+// LocalizedName returns the name for lang, falling back to English.
+// It returns an empty string if neither is available.
+func LocalizedName(names []Name, lang string) string {
+	fallback := ""
+	for _, n := range names {
+		if n.Language.Name == lang {
+			return n.Name
+		}
+		if n.Language.Name == DefaultLanguage {
+			fallback = n.Name
+		}
+	}
+	return fallback
+}
+
+// LocalizedFlavorText returns the most recent flavor text for lang, falling back to English.
+// Line breaks and page breaks from the game text are replaced by spaces.
+func LocalizedFlavorText(entries []FlavorText, lang string) string {
+	text, fallback := "", ""
+	for _, e := range entries {
+		switch e.Language.Name {
+		case lang:
+			text = e.FlavorText
+		case DefaultLanguage:
+			fallback = e.FlavorText
+		}
+	}
+	if text == "" {
+		text = fallback
+	}
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// Language represents the structure of a single language (e.g. fr) from the PokeAPI.
+type Language struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Official bool   `json:"official"`
+	Iso639   string `json:"iso639"`
+	Iso3166  string `json:"iso3166"`
+	Names    []Name `json:"names"`
+}
+
+// GetLanguage fetches a single language by its code and parses the response.
+func GetLanguage(code string) (*Language, error) {
+	body, err := Get(PokeAPIBaseURL + "language/" + code)
+	if err != nil {
+		return nil, err
+	}
+	var language Language
+	err = json.Unmarshal(body, &language)
+	if err != nil {
+		return nil, err
+	}
+	return &language, nil
+}
+
+// ResourceURL returns the URL of a single resource by name or ID, the same
+// URL its getter fetches. Prefetching through it warms the getter's cache entry.
+func ResourceURL(resource, name string) string {
+	return PokeAPIBaseURL + resource + "/" + name
+}
+
+// IDFromURL returns the numeric ID at the end of a resource URL such as
+// https://pokeapi.co/api/v2/pokemon-species/16/, or 0 if there is none.
+func IDFromURL(url string) int {
+	parts := strings.Split(strings.TrimSuffix(url, "/"), "/")
+	id, err := strconv.Atoi(parts[len(parts)-1])
+	if err != nil {
+		return 0
+	}
+	return id
+}
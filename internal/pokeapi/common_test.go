@@ -0,0 +1,35 @@
+package pokeapi
+
+import "testing"
+
+func TestLocalizedName(t *testing.T) {
+	names := []Name{
+		{Name: "Bourg Palette", Language: NamedAPIResource{Name: "fr"}},
+		{Name: "Pallet Town", Language: NamedAPIResource{Name: "en"}},
+	}
+	cases := map[string]string{
+		"fr": "Bourg Palette",
+		"en": "Pallet Town",
+		"de": "Pallet Town", // falls back to English
+	}
+	for lang, expected := range cases {
+		if actual := LocalizedName(names, lang); actual != expected {
+			t.Errorf("Expected %q for %s, got %q", expected, lang, actual)
+		}
+	}
+
+	if actual := LocalizedName(nil, "fr"); actual != "" {
+		t.Errorf("Expected empty name without names, got %q", actual)
+	}
+}
+
+func TestLocalizedFlavorText(t *testing.T) {
+	entries := []FlavorText{
+		{FlavorText: "Old\ntext", Language: NamedAPIResource{Name: "en"}},
+		{FlavorText: "Newer\ntext\fhere", Language: NamedAPIResource{Name: "en"}},
+	}
+	expected := "Newer text here"
+	if actual := LocalizedFlavorText(entries, "fr"); actual != expected {
+		t.Errorf("Expected %q, got %q", expected, actual)
+	}
+}
@@ -0,0 +1,45 @@
+package pokeapi
+
+import "encoding/json"
+
+// EncounterDetail describes how and at what levels a Pokémon can be encountered.
+type EncounterDetail struct {
+	MinLevel        int                `json:"min_level"`
+	MaxLevel        int                `json:"max_level"`
+	ConditionValues []NamedAPIResource `json:"condition_values"`
+	Chance          int                `json:"chance"`
+	Method          NamedAPIResource   `json:"method"`
+}
+
+// VersionEncounterDetail groups encounter details for a single game version.
+type VersionEncounterDetail struct {
+	Version          NamedAPIResource  `json:"version"`
+	MaxChance        int               `json:"max_chance"`
+	EncounterDetails []EncounterDetail `json:"encounter_details"`
+}
+
+// LocationAreaEncounter is a single location area in which a Pokémon can be found.
+type LocationAreaEncounter struct {
+	LocationArea   NamedAPIResource         `json:"location_area"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
+}
+
+// ParsePokemonEncounters parses the JSON response from a Pokémon's encounters endpoint.
+func ParsePokemonEncounters(data []byte) ([]LocationAreaEncounter, error) {
+	var encounters []LocationAreaEncounter
+	err := json.Unmarshal(data, &encounters)
+	if err != nil {
+		return nil, err
+	}
+	return encounters, nil
+}
+
+// GetPokemonEncounters fetches the location areas a Pokémon can be found in.
+// The URL is the one given in Pokemon.LocationAreaEncounters.
+func GetPokemonEncounters(url string) ([]LocationAreaEncounter, error) {
+	body, err := Get(url)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePokemonEncounters(body)
+}
@@ -0,0 +1,37 @@
+package pokeapi
+
+import "sync"
+
+// resourceNames holds the full list of names per resource type (e.g. "pokemon",
+// "location-area"), fetched once per session from the list endpoints.
+var resourceNames = struct {
+	mutex sync.Mutex
+	names map[string][]string
+}{names: make(map[string][]string)}
+
+// GetResourceNames returns the name of every resource of the given type, in API order.
+// The list is fetched on first use and kept for the rest of the session.
+func GetResourceNames(resource string) ([]string, error) {
+	resourceNames.mutex.Lock()
+	defer resourceNames.mutex.Unlock()
+
+	if names, found := resourceNames.names[resource]; found {
+		return names, nil
+	}
+
+	body, err := Get(PokeAPIBaseURL + resource + "?limit=100000")
+	if err != nil {
+		return nil, err
+	}
+	list, err := ParseNamedAPIResourceList(body)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(list.Results))
+	for _, r := range list.Results {
+		names = append(names, r.Name)
+	}
+	resourceNames.names[resource] = names
+	return names, nil
+}
@@ -0,0 +1,34 @@
+package pokeapi
+
+import "encoding/json"
+
+// Item represents the structure of a single item (e.g. great-ball) from the PokeAPI.
+// Cost is the price in a Poke Mart, 0 for items that can't be bought.
+type Item struct {
+	ID         int                `json:"id"`
+	Name       string             `json:"name"`
+	Cost       int                `json:"cost"`
+	FlingPower *int               `json:"fling_power"`
+	Category   NamedAPIResource   `json:"category"`
+	Attributes []NamedAPIResource `json:"attributes"`
+	Names      []Name             `json:"names"`
+}
+
+// ParseItem parses the JSON response for a single item into an Item struct.
+func ParseItem(data []byte) (*Item, error) {
+	var item Item
+	err := json.Unmarshal(data, &item)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// GetItem fetches a single item by name or ID and parses the response.
+func GetItem(itemName string) (*Item, error) {
+	body, err := Get(PokeAPIBaseURL + "item/" + itemName)
+	if err != nil {
+		return nil, err
+	}
+	return ParseItem(body)
+}
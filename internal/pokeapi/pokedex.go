@@ -0,0 +1,63 @@
+package pokeapi
+
+import "encoding/json"
+
+// Generation represents the structure of a single generation (e.g. generation-i) from the PokeAPI.
+type Generation struct {
+	ID             int                `json:"id"`
+	Name           string             `json:"name"`
+	MainRegion     NamedAPIResource   `json:"main_region"`
+	PokemonSpecies []NamedAPIResource `json:"pokemon_species"`
+	Types          []NamedAPIResource `json:"types"`
+	VersionGroups  []NamedAPIResource `json:"version_groups"`
+}
+
+// Pokedex represents the structure of a single Pokédex (e.g. kanto, national) from the PokeAPI.
+type Pokedex struct {
+	ID             int              `json:"id"`
+	Name           string           `json:"name"`
+	IsMainSeries   bool             `json:"is_main_series"`
+	Region         NamedAPIResource `json:"region"`
+	PokemonEntries []struct {
+		EntryNumber    int              `json:"entry_number"`
+		PokemonSpecies NamedAPIResource `json:"pokemon_species"`
+	} `json:"pokemon_entries"`
+}
+
+// ParseGeneration parses the JSON response for a single generation into a Generation struct.
+func ParseGeneration(data []byte) (*Generation, error) {
+	var generation Generation
+	err := json.Unmarshal(data, &generation)
+	if err != nil {
+		return nil, err
+	}
+	return &generation, nil
+}
+
+// GetGeneration fetches a single generation by name or ID and parses the response.
+func GetGeneration(generationName string) (*Generation, error) {
+	body, err := Get(PokeAPIBaseURL + "generation/" + generationName)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGeneration(body)
+}
+
+// ParsePokedex parses the JSON response for a single Pokédex into a Pokedex struct.
+func ParsePokedex(data []byte) (*Pokedex, error) {
+	var pokedex Pokedex
+	err := json.Unmarshal(data, &pokedex)
+	if err != nil {
+		return nil, err
+	}
+	return &pokedex, nil
+}
+
+// GetPokedex fetches a single Pokédex by name or ID and parses the response.
+func GetPokedex(pokedexName string) (*Pokedex, error) {
+	body, err := Get(PokeAPIBaseURL + "pokedex/" + pokedexName)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePokedex(body)
+}
@@ -0,0 +1,47 @@
+package pokeapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// requestInterval is the minimum time between two requests sent to PokeAPI.
+// Cached responses don't count against it.
+const requestInterval = 50 * time.Millisecond
+
+var limiter = &rateLimiter{interval: requestInterval}
+
+// rateLimiter spaces out requests so that at most one starts per interval,
+// however many goroutines are fetching.
+type rateLimiter struct {
+	mutex    sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// wait blocks until the caller may send a request, or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mutex.Lock()
+	now := time.Now()
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next = slot.Add(l.interval)
+	l.mutex.Unlock()
+
+	delay := time.Until(slot)
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
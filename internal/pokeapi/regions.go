@@ -0,0 +1,69 @@
+package pokeapi
+
+import "encoding/json"
+
+// Region represents the structure of a single region (e.g. kanto) from the PokeAPI.
+type Region struct {
+	ID             int                `json:"id"`
+	Name           string             `json:"name"`
+	Locations      []NamedAPIResource `json:"locations"`
+	MainGeneration NamedAPIResource   `json:"main_generation"`
+	Pokedexes      []NamedAPIResource `json:"pokedexes"`
+	VersionGroups  []NamedAPIResource `json:"version_groups"`
+}
+
+// Location represents the structure of a single location (e.g. pallet-town) from the PokeAPI.
+// A location is made up of one or more location areas.
+type Location struct {
+	ID     int                `json:"id"`
+	Name   string             `json:"name"`
+	Region NamedAPIResource   `json:"region"`
+	Areas  []NamedAPIResource `json:"areas"`
+}
+
+// GetRegions fetches the list of all regions.
+func GetRegions() (*NamedAPIResourceList, error) {
+	body, err := Get(PokeAPIBaseURL + "region/")
+	if err != nil {
+		return nil, err
+	}
+	return ParseNamedAPIResourceList(body)
+}
+
+// ParseRegion parses the JSON response for a single region into a Region struct.
+func ParseRegion(data []byte) (*Region, error) {
+	var region Region
+	err := json.Unmarshal(data, &region)
+	if err != nil {
+		return nil, err
+	}
+	return &region, nil
+}
+
+// GetRegion fetches a single region by name and parses the response.
+func GetRegion(regionName string) (*Region, error) {
+	body, err := Get(PokeAPIBaseURL + "region/" + regionName)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegion(body)
+}
+
+// ParseLocation parses the JSON response for a single location into a Location struct.
+func ParseLocation(data []byte) (*Location, error) {
+	var location Location
+	err := json.Unmarshal(data, &location)
+	if err != nil {
+		return nil, err
+	}
+	return &location, nil
+}
+
+// GetLocation fetches a single location by name and parses the response.
+func GetLocation(locationName string) (*Location, error) {
+	body, err := Get(PokeAPIBaseURL + "location/" + locationName)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLocation(body)
+}
@@ -0,0 +1,136 @@
+package pokeapi
+
+import "encoding/json"
+
+// PokemonSpecies represents the structure of a single Pokémon species from the PokeAPI.
+// A species groups the Pokémon varieties (forms) that share a Pokédex entry.
+type PokemonSpecies struct {
+	ID             int              `json:"id"`
+	Name           string           `json:"name"`
+	Order          int              `json:"order"`
+	CaptureRate    int              `json:"capture_rate"`
+	BaseHappiness  int              `json:"base_happiness"`
+	IsLegendary    bool             `json:"is_legendary"`
+	IsMythical     bool             `json:"is_mythical"`
+	GrowthRate     NamedAPIResource `json:"growth_rate"`
+	Generation     NamedAPIResource `json:"generation"`
+	PokedexNumbers []struct {
+		EntryNumber int              `json:"entry_number"`
+		Pokedex     NamedAPIResource `json:"pokedex"`
+	} `json:"pokedex_numbers"`
+	EvolutionChain struct {
+		URL string `json:"url"`
+	} `json:"evolution_chain"`
+	Names             []Name       `json:"names"`
+	FlavorTextEntries []FlavorText `json:"flavor_text_entries"`
+	Genera            []struct {
+		Genus    string           `json:"genus"`
+		Language NamedAPIResource `json:"language"`
+	} `json:"genera"`
+	Varieties []struct {
+		IsDefault bool             `json:"is_default"`
+		Pokemon   NamedAPIResource `json:"pokemon"`
+	} `json:"varieties"`
+}
+
+// Genus returns the species' genus (e.g. "Mouse Pokémon") for lang, falling back to English.
+func (s *PokemonSpecies) Genus(lang string) string {
+	fallback := ""
+	for _, g := range s.Genera {
+		if g.Language.Name == lang {
+			return g.Genus
+		}
+		if g.Language.Name == DefaultLanguage {
+			fallback = g.Genus
+		}
+	}
+	return fallback
+}
+
+// ParsePokemonSpecies parses the JSON response for a single species into a PokemonSpecies struct.
+func ParsePokemonSpecies(data []byte) (*PokemonSpecies, error) {
+	var species PokemonSpecies
+	err := json.Unmarshal(data, &species)
+	if err != nil {
+		return nil, err
+	}
+	return &species, nil
+}
+
+// GetPokemonSpecies fetches a single species by name or ID and parses the response.
+func GetPokemonSpecies(speciesName string) (*PokemonSpecies, error) {
+	body, err := Get(PokeAPIBaseURL + "pokemon-species/" + speciesName)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePokemonSpecies(body)
+}
+
+// Move represents the structure of a single move from the PokeAPI.
+// Power, accuracy and PP are nil for moves where they don't apply.
+type Move struct {
+	ID                int              `json:"id"`
+	Name              string           `json:"name"`
+	Accuracy          *int             `json:"accuracy"`
+	Power             *int             `json:"power"`
+	PP                *int             `json:"pp"`
+	Priority          int              `json:"priority"`
+	Type              NamedAPIResource `json:"type"`
+	DamageClass       NamedAPIResource `json:"damage_class"`
+	Names             []Name           `json:"names"`
+	FlavorTextEntries []FlavorText     `json:"flavor_text_entries"`
+}
+
+// ParseMove parses the JSON response for a single move into a Move struct.
+func ParseMove(data []byte) (*Move, error) {
+	var move Move
+	err := json.Unmarshal(data, &move)
+	if err != nil {
+		return nil, err
+	}
+	return &move, nil
+}
+
+// GetMove fetches a single move by name or ID and parses the response.
+func GetMove(moveName string) (*Move, error) {
+	body, err := Get(PokeAPIBaseURL + "move/" + moveName)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMove(body)
+}
+
+// GrowthRate represents the structure of a single growth rate (e.g. medium-slow)
+// from the PokeAPI: the total experience a species on this curve needs for each level.
+type GrowthRate struct {
+	ID             int                         `json:"id"`
+	Name           string                      `json:"name"`
+	Formula        string                      `json:"formula"`
+	Levels         []GrowthRateExperienceLevel `json:"levels"`
+	PokemonSpecies []NamedAPIResource          `json:"pokemon_species"`
+}
+
+// GrowthRateExperienceLevel is the total experience needed to reach a level.
+type GrowthRateExperienceLevel struct {
+	Level      int `json:"level"`
+	Experience int `json:"experience"`
+}
+
+// ParseGrowthRate parses the JSON response for a single growth rate into a GrowthRate struct.
+func ParseGrowthRate(data []byte) (*GrowthRate, error) {
+	var rate GrowthRate
+	err := json.Unmarshal(data, &rate)
+	if err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
+
+// GetGrowthRate fetches a single growth rate by name or ID and parses the response.
+func GetGrowthRate(rateName string) (*GrowthRate, error) {
+	body, err := Get(PokeAPIBaseURL + "growth-rate/" + rateName)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGrowthRate(body)
+}
@@ -0,0 +1,100 @@
+package pokeapi
+
+import "encoding/json"
+
+// Nature represents the structure of a single nature (e.g. adamant) from the PokeAPI.
+// Neutral natures have no increased or decreased stat and no flavor preference.
+type Nature struct {
+	ID            int               `json:"id"`
+	Name          string            `json:"name"`
+	DecreasedStat *NamedAPIResource `json:"decreased_stat"`
+	IncreasedStat *NamedAPIResource `json:"increased_stat"`
+	HatesFlavor   *NamedAPIResource `json:"hates_flavor"`
+	LikesFlavor   *NamedAPIResource `json:"likes_flavor"`
+}
+
+// Characteristic represents the structure of a single characteristic from the PokeAPI,
+// the flavor text shown for a Pokémon's highest IV.
+type Characteristic struct {
+	ID             int              `json:"id"`
+	GeneModulo     int              `json:"gene_modulo"`
+	PossibleValues []int            `json:"possible_values"`
+	HighestStat    NamedAPIResource `json:"highest_stat"`
+	Descriptions   []struct {
+		Description string           `json:"description"`
+		Language    NamedAPIResource `json:"language"`
+	} `json:"descriptions"`
+}
+
+// Stat represents the structure of a single stat (e.g. attack) from the PokeAPI.
+type Stat struct {
+	ID               int    `json:"id"`
+	Name             string `json:"name"`
+	GameIndex        int    `json:"game_index"`
+	IsBattleOnly     bool   `json:"is_battle_only"`
+	AffectingNatures struct {
+		Increase []NamedAPIResource `json:"increase"`
+		Decrease []NamedAPIResource `json:"decrease"`
+	} `json:"affecting_natures"`
+	Characteristics []struct {
+		URL string `json:"url"`
+	} `json:"characteristics"`
+	MoveDamageClass *NamedAPIResource `json:"move_damage_class"`
+}
+
+// ParseNature parses the JSON response for a single nature into a Nature struct.
+func ParseNature(data []byte) (*Nature, error) {
+	var nature Nature
+	err := json.Unmarshal(data, &nature)
+	if err != nil {
+		return nil, err
+	}
+	return &nature, nil
+}
+
+// GetNature fetches a single nature by name or ID and parses the response.
+func GetNature(natureName string) (*Nature, error) {
+	body, err := Get(PokeAPIBaseURL + "nature/" + natureName)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNature(body)
+}
+
+// ParseCharacteristic parses the JSON response for a single characteristic into a Characteristic struct.
+func ParseCharacteristic(data []byte) (*Characteristic, error) {
+	var characteristic Characteristic
+	err := json.Unmarshal(data, &characteristic)
+	if err != nil {
+		return nil, err
+	}
+	return &characteristic, nil
+}
+
+// GetCharacteristic fetches a single characteristic by ID and parses the response.
+func GetCharacteristic(characteristicID string) (*Characteristic, error) {
+	body, err := Get(PokeAPIBaseURL + "characteristic/" + characteristicID)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCharacteristic(body)
+}
+
+// ParseStat parses the JSON response for a single stat into a Stat struct.
+func ParseStat(data []byte) (*Stat, error) {
+	var stat Stat
+	err := json.Unmarshal(data, &stat)
+	if err != nil {
+		return nil, err
+	}
+	return &stat, nil
+}
+
+// GetStat fetches a single stat by name or ID and parses the response.
+func GetStat(statName string) (*Stat, error) {
+	body, err := Get(PokeAPIBaseURL + "stat/" + statName)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStat(body)
+}
@@ -0,0 +1,74 @@
+package pokeapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+// PokemonStat is a single base stat of a Pokémon.
+type PokemonStat struct {
+	BaseStat int              `json:"base_stat"`
+	Effort   int              `json:"effort"`
+	Stat     NamedAPIResource `json:"stat"`
+}
+
+// PokemonType is one of a Pokémon's types.
+type PokemonType struct {
+	Slot int              `json:"slot"`
+	Type NamedAPIResource `json:"type"`
+}
+
+// PokemonSummary is a lightweight view of a Pokémon holding only what the REPL
+// needs most of the time. Unlike Pokemon, decoding it skips the sprites tree and
+// the move list, which make up nearly all of the payload.
+type PokemonSummary struct {
+	ID                     int              `json:"id"`
+	Name                   string           `json:"name"`
+	BaseExperience         int              `json:"base_experience"`
+	Height                 int              `json:"height"`
+	Weight                 int              `json:"weight"`
+	Species                NamedAPIResource `json:"species"`
+	LocationAreaEncounters string           `json:"location_area_encounters"`
+	Stats                  []PokemonStat    `json:"stats"`
+	Types                  []PokemonType    `json:"types"`
+}
+
+// ParsePokemonSummary parses the JSON response for a single Pokémon into a PokemonSummary.
+// Fields the summary doesn't hold are skipped by the decoder without being stored.
+func ParsePokemonSummary(data []byte) (*PokemonSummary, error) {
+	var summary PokemonSummary
+	err := json.Unmarshal(data, &summary)
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// DecodePokemonSummary decodes a single Pokémon from r into a PokemonSummary
+// with a json.Decoder, so a response body can be decoded as it is read. The
+// decoder still buffers the value it decodes, so ParsePokemonSummary is the
+// cheaper choice for data already in memory.
+func DecodePokemonSummary(r io.Reader) (*PokemonSummary, error) {
+	var summary PokemonSummary
+	err := json.NewDecoder(r).Decode(&summary)
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// GetPokemonSummary fetches a single Pokémon by name or ID and decodes it into
+// a PokemonSummary while the response streams in.
+func GetPokemonSummary(pokemanName string) (*PokemonSummary, error) {
+	var summary *PokemonSummary
+	err := GetStream(context.Background(), ResourceURL("pokemon", pokemanName), func(r io.Reader) error {
+		var err error
+		summary, err = DecodePokemonSummary(r)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
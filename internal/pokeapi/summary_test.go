@@ -0,0 +1,129 @@
+package pokeapi
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// testdata/bulbasaur.json has the shape and size of a GET pokemon/1 response,
+// including the full sprites tree and move list.
+func readPokemonFixture(tb testing.TB) []byte {
+	tb.Helper()
+	data, err := os.ReadFile("testdata/bulbasaur.json")
+	if err != nil {
+		tb.Fatalf("Could not read fixture: %v", err)
+	}
+	return data
+}
+
+func TestParsePokemonSummary(t *testing.T) {
+	data := readPokemonFixture(t)
+
+	full, err := ParsePokemon(data)
+	if err != nil {
+		t.Fatalf("ParsePokemon failed: %v", err)
+	}
+	summary, err := ParsePokemonSummary(data)
+	if err != nil {
+		t.Fatalf("ParsePokemonSummary failed: %v", err)
+	}
+
+	if summary.ID != full.ID || summary.Name != full.Name || summary.BaseExperience != full.BaseExperience ||
+		summary.Height != full.Height || summary.Weight != full.Weight {
+		t.Errorf("Summary %+v does not match Pokemon %s (#%d)", summary, full.Name, full.ID)
+	}
+	if summary.Species.Name != full.Species.Name || summary.LocationAreaEncounters != full.LocationAreaEncounters {
+		t.Errorf("Expected species %s and encounters %s, got %s and %s",
+			full.Species.Name, full.LocationAreaEncounters, summary.Species.Name, summary.LocationAreaEncounters)
+	}
+	if len(summary.Stats) != len(full.Stats) {
+		t.Fatalf("Expected %d stats, got %d", len(full.Stats), len(summary.Stats))
+	}
+	for i, stat := range full.Stats {
+		if summary.Stats[i].Stat.Name != stat.Stat.Name || summary.Stats[i].BaseStat != stat.BaseStat {
+			t.Errorf("Stat %d mismatch: expected %s %d, got %+v", i, stat.Stat.Name, stat.BaseStat, summary.Stats[i])
+		}
+	}
+	if len(summary.Types) != 2 || summary.Types[0].Type.Name != "grass" || summary.Types[1].Type.Name != "poison" {
+		t.Errorf("Expected grass/poison types, got %+v", summary.Types)
+	}
+}
+
+func TestDecodePokemonSummary(t *testing.T) {
+	data := readPokemonFixture(t)
+	expected, err := ParsePokemonSummary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual, err := DecodePokemonSummary(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("DecodePokemonSummary failed: %v", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestParsePokemonSummaryInvalid(t *testing.T) {
+	for _, input := range []string{"", "[]", `{"id": "one"}`, `{"id": 1`} {
+		if _, err := ParsePokemonSummary([]byte(input)); err == nil {
+			t.Errorf("Expected an error for %q", input)
+		}
+	}
+}
+
+func TestParsePokemonSummaryAllocatesLess(t *testing.T) {
+	data := readPokemonFixture(t)
+	full := testing.AllocsPerRun(10, func() {
+		if _, err := ParsePokemon(data); err != nil {
+			t.Fatal(err)
+		}
+	})
+	summary := testing.AllocsPerRun(10, func() {
+		if _, err := ParsePokemonSummary(data); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	if summary*10 > full {
+		t.Errorf("Expected ParsePokemonSummary to make under a tenth of ParsePokemon's allocations, got %.0f vs %.0f", summary, full)
+	}
+}
+
+// BenchmarkParsePokemon, BenchmarkParsePokemonSummary and
+// BenchmarkDecodePokemonSummary compare the cost of decoding the same payload;
+// run with -benchmem to see bytes per operation.
+func BenchmarkParsePokemon(b *testing.B) {
+	data := readPokemonFixture(b)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	for b.Loop() {
+		if _, err := ParsePokemon(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParsePokemonSummary(b *testing.B) {
+	data := readPokemonFixture(b)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	for b.Loop() {
+		if _, err := ParsePokemonSummary(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodePokemonSummary(b *testing.B) {
+	data := readPokemonFixture(b)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	for b.Loop() {
+		if _, err := DecodePokemonSummary(bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,317 @@
+package save
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// migration upgrades a decoded save, in place, from one version to the next.
+type migration func(raw map[string]any) error
+
+// migrations holds the upgrade from each version to the next, keyed by the
+// version it upgrades from. Every change to the save format bumps
+// CurrentVersion and registers a migration here, along with a
+// testdata/v<N>.json sample of the old format for the golden-file tests.
+var migrations = map[int]migration{
+	1: migrateV1ToV2,
+	2: migrateV2ToV3,
+	3: migrateV3ToV4,
+	4: migrateV4ToV5,
+	5: migrateV5ToV6,
+	6: migrateV6ToV7,
+	7: migrateV7ToV8,
+	8: migrateV8ToV9,
+}
+
+// migrateV1ToV2 adds the profile name, settings and history introduced with profiles.
+func migrateV1ToV2(raw map[string]any) error {
+	setDefault(raw, "profile", "")
+	setDefault(raw, "settings", map[string]any{"lang": "en", "prefetch": false})
+	setDefault(raw, "history", []any{})
+	return nil
+}
+
+// migrateV2ToV3 replaces the full PokeAPI payloads stored for caught Pokemon
+// with compact records. Old catches had no level, IVs or nature, so they get
+// level 5, zero IVs and the neutral hardy nature, caught when the save was.
+// The v3 record and its stat formula are written out here rather than taken
+// from the game package, so the output stays the same as that package changes.
+func migrateV2ToV3(raw map[string]any) error {
+	savedAt, _ := raw["saved_at"].(string)
+	caughtAt, err := time.Parse(time.RFC3339Nano, savedAt)
+	if err != nil {
+		return fmt.Errorf("invalid saved_at %q: %w", savedAt, err)
+	}
+
+	caught, _ := raw["caught_pokemon"].(map[string]any)
+	for name, entry := range caught {
+		payload, ok := entry.(map[string]any)
+		if !ok {
+			return fmt.Errorf("caught Pokemon %s is not an object", name)
+		}
+		caught[name] = v3Record(payload, caughtAt)
+	}
+	return nil
+}
+
+// v3StatNames are the stats of a v3 record.
+var v3StatNames = []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"}
+
+// v3Level is the level given to Pokemon caught before levels were stored.
+const v3Level = 5
+
+// v3Record builds the v3 record for a Pokemon stored as its PokeAPI payload.
+func v3Record(payload map[string]any, caughtAt time.Time) map[string]any {
+	species, _ := payload["species"].(map[string]any)
+	speciesURL, _ := species["url"].(string)
+	speciesID := rawIDFromURL(speciesURL)
+	if speciesID == 0 {
+		speciesID, _ = rawInt(payload["id"])
+	}
+
+	base := map[string]int{}
+	statList, _ := payload["stats"].([]any)
+	for _, entry := range statList {
+		stat, _ := entry.(map[string]any)
+		ref, _ := stat["stat"].(map[string]any)
+		statName, _ := ref["name"].(string)
+		base[statName], _ = rawInt(stat["base_stat"])
+	}
+
+	// Gen III formula with zero IVs, no EVs and a neutral nature
+	ivs := map[string]any{}
+	stats := map[string]any{}
+	for _, statName := range v3StatNames {
+		ivs[statName] = 0
+		core := 2 * base[statName] * v3Level / 100
+		if statName == "hp" {
+			stats[statName] = core + v3Level + 10
+		} else {
+			stats[statName] = core + 5
+		}
+	}
+
+	types := []any{}
+	typeList, _ := payload["types"].([]any)
+	for _, entry := range typeList {
+		slot, _ := entry.(map[string]any)
+		ref, _ := slot["type"].(map[string]any)
+		types = append(types, ref["name"])
+	}
+
+	return map[string]any{
+		"species_id": speciesID,
+		"species":    species["name"],
+		"name":       payload["name"],
+		"caught_at":  caughtAt.Format(time.RFC3339Nano),
+		"level":      v3Level,
+		"ivs":        ivs,
+		"nature":     "hardy",
+		"types":      types,
+		"stats":      stats,
+	}
+}
+
+// rawIDFromURL returns the numeric ID at the end of a resource URL, or 0.
+func rawIDFromURL(url string) int {
+	parts := strings.Split(strings.TrimSuffix(url, "/"), "/")
+	id, err := strconv.Atoi(parts[len(parts)-1])
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// migrateV3ToV4 turns the caught Pokemon map, keyed by name, into a list of
+// individuals with IDs, numbered in name order, and records the species caught.
+func migrateV3ToV4(raw map[string]any) error {
+	caught, _ := raw["caught_pokemon"].(map[string]any)
+	names := make([]string, 0, len(caught))
+	for name := range caught {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	list := make([]any, 0, len(names))
+	species := make([]any, 0, len(names))
+	for i, name := range names {
+		record, ok := caught[name].(map[string]any)
+		if !ok {
+			return fmt.Errorf("caught Pokemon %s is not an object", name)
+		}
+		record["id"] = i + 1
+		list = append(list, record)
+		species = append(species, record["species"])
+	}
+
+	raw["caught_pokemon"] = list
+	raw["next_id"] = len(names) + 1
+	raw["species_caught"] = species
+	return nil
+}
+
+// v5 storage layout: the party size and the number and size of PC boxes.
+const (
+	v5PartySize = 6
+	v5BoxCount  = 8
+	v5BoxSize   = 30
+)
+
+// migrateV4ToV5 adds the party and PC boxes, filling the party with the
+// first six Pokemon by ID and boxing the rest in order. More boxes are added
+// if the eight don't hold everything, so no Pokemon is dropped.
+func migrateV4ToV5(raw map[string]any) error {
+	caught, _ := raw["caught_pokemon"].([]any)
+	var ids []int
+	for _, entry := range caught {
+		record, ok := entry.(map[string]any)
+		if !ok {
+			return fmt.Errorf("caught Pokemon entry is not an object")
+		}
+		id, ok := rawInt(record["id"])
+		if !ok {
+			return fmt.Errorf("caught Pokemon without an ID")
+		}
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	party := []any{}
+	boxes := make([]any, v5BoxCount)
+	for i := range boxes {
+		boxes[i] = []any{}
+	}
+	for i, id := range ids {
+		if i < v5PartySize {
+			party = append(party, id)
+			continue
+		}
+		box := (i - v5PartySize) / v5BoxSize
+		if box == len(boxes) {
+			boxes = append(boxes, []any{})
+		}
+		boxes[box] = append(boxes[box].([]any), id)
+	}
+
+	raw["storage"] = map[string]any{"party": party, "boxes": boxes}
+	return nil
+}
+
+// migrateV5ToV6 adds the item bag, holding the starter items of version 6.
+func migrateV5ToV6(raw map[string]any) error {
+	setDefault(raw, "bag", map[string]any{"poke-ball": 10, "potion": 2})
+	return nil
+}
+
+// migrateV6ToV7 adds money, giving existing saves the $3000 a version 7 game
+// started with.
+func migrateV6ToV7(raw map[string]any) error {
+	setDefault(raw, "money", 3000)
+	return nil
+}
+
+// migrateV7ToV8 adds the player's current location area. Older saves haven't
+// traveled anywhere yet.
+func migrateV7ToV8(raw map[string]any) error {
+	setDefault(raw, "location", "")
+	return nil
+}
+
+// migrateV8ToV9 adds experience to every caught Pokemon. Growth rates aren't
+// available offline, so it starts at 0; the first experience gained brings it
+// up to the minimum for the Pokemon's level.
+func migrateV8ToV9(raw map[string]any) error {
+	caught, _ := raw["caught_pokemon"].([]any)
+	for _, entry := range caught {
+		record, ok := entry.(map[string]any)
+		if !ok {
+			return fmt.Errorf("caught Pokemon entry is not an object")
+		}
+		setDefault(record, "exp", 0)
+	}
+	return nil
+}
+
+// rawInt reads a number set either by json.Unmarshal or by an earlier migration.
+func rawInt(value any) (int, bool) {
+	switch n := value.(type) {
+	case float64:
+		return int(n), n == float64(int(n))
+	case int:
+		return n, true
+	}
+	return 0, false
+}
+
+// setDefault sets raw[key] to value unless the key is already present.
+func setDefault(raw map[string]any, key string, value any) {
+	if _, found := raw[key]; !found {
+		raw[key] = value
+	}
+}
+
+// saveVersion returns the version recorded in a decoded save. Saves written
+// before the field existed are version 1.
+func saveVersion(raw map[string]any) (int, error) {
+	value, found := raw["version"]
+	if !found {
+		return 1, nil
+	}
+	version, ok := rawInt(value)
+	if !ok || version < 1 {
+		return 0, fmt.Errorf("invalid save version %v", value)
+	}
+	return version, nil
+}
+
+// migrate upgrades the save data to CurrentVersion one step at a time,
+// returning the upgraded JSON and the version it started from.
+func migrate(data []byte) ([]byte, int, error) {
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, 0, err
+	}
+
+	from, err := saveVersion(raw)
+	if err != nil {
+		return nil, 0, err
+	}
+	if from > CurrentVersion {
+		return nil, 0, fmt.Errorf("save has version %d, newer than supported version %d", from, CurrentVersion)
+	}
+	if from == CurrentVersion {
+		return data, from, nil
+	}
+
+	for version := from; version < CurrentVersion; version++ {
+		step, found := migrations[version]
+		if !found {
+			return nil, 0, fmt.Errorf("no migration from save version %d", version)
+		}
+		if err := step(raw); err != nil {
+			return nil, 0, fmt.Errorf("migrating save from version %d: %w", version, err)
+		}
+		raw["version"] = version + 1
+	}
+
+	upgraded, err := json.Marshal(raw)
+	if err != nil {
+		return nil, 0, err
+	}
+	return upgraded, from, nil
+}
+
+// backupPath returns where the pre-migration copy of a save of the given version is kept.
+func backupPath(path string, version int) string {
+	return fmt.Sprintf("%s.v%d.bak", path, version)
+}
+
+// writeBackup copies the original bytes of a save before it is migrated.
+func writeBackup(path string, version int, data []byte) error {
+	return os.WriteFile(backupPath(path, version), data, 0o644)
+}
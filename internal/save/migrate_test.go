@@ -0,0 +1,137 @@
+package save
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// TestLoadHistoricalVersions loads a sample save of every version ever
+// written, testdata/v<N>.json, and compares the result with
+// testdata/v<N>.golden.json. Run with -update after adding a version.
+func TestLoadHistoricalVersions(t *testing.T) {
+	for version := 1; version <= CurrentVersion; version++ {
+		t.Run(fmt.Sprintf("v%d", version), func(t *testing.T) {
+			original, err := os.ReadFile(filepath.Join("testdata", fmt.Sprintf("v%d.json", version)))
+			if err != nil {
+				t.Fatalf("Missing sample save for version %d: %v", version, err)
+			}
+			path := filepath.Join(t.TempDir(), "save.json")
+			if err := os.WriteFile(path, original, 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			file, err := Load(path)
+			if err != nil {
+				t.Fatalf("Expected no error loading version %d, got: %v", version, err)
+			}
+			if file.Version != CurrentVersion {
+				t.Errorf("Expected version %d after loading, got %d", CurrentVersion, file.Version)
+			}
+
+			actual, err := json.MarshalIndent(file, "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			golden := filepath.Join("testdata", fmt.Sprintf("v%d.golden.json", version))
+			if *update {
+				if err := os.WriteFile(golden, append(actual, '\n'), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			expected, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("Missing golden file, run with -update: %v", err)
+			}
+			if !bytes.Equal(bytes.TrimSpace(actual), bytes.TrimSpace(expected)) {
+				t.Errorf("Loaded save does not match %s:\n%s", golden, actual)
+			}
+
+			backup, err := os.ReadFile(backupPath(path, version))
+			if version == CurrentVersion {
+				if err == nil {
+					t.Error("Expected no backup for a save that needed no migration")
+				}
+				return
+			}
+			if err != nil || !bytes.Equal(backup, original) {
+				t.Errorf("Expected the original save to be backed up, got err %v", err)
+			}
+			if _, err := Load(path); err != nil {
+				t.Errorf("Expected the upgraded save to load again, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestReadLeavesOlderSaveAlone(t *testing.T) {
+	original, err := os.ReadFile(filepath.Join("testdata", "v1.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "backup.json")
+	if err := os.WriteFile(path, original, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := Read(path)
+	if err != nil {
+		t.Fatalf("Expected no error reading version 1, got: %v", err)
+	}
+	if file.Version != CurrentVersion {
+		t.Errorf("Expected version %d after reading, got %d", CurrentVersion, file.Version)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || !bytes.Equal(data, original) {
+		t.Errorf("Expected the file to be left untouched, got err %v", err)
+	}
+	if _, err := os.Stat(backupPath(path, 1)); err == nil {
+		t.Error("Expected no backup to be written")
+	}
+}
+
+func TestMigrationsRegistered(t *testing.T) {
+	for version := 1; version < CurrentVersion; version++ {
+		if _, found := migrations[version]; !found {
+			t.Errorf("Missing migration from version %d to %d", version, version+1)
+		}
+	}
+}
+
+func TestMigrateV2ToV3InvalidSavedAt(t *testing.T) {
+	raw := map[string]any{"saved_at": "yesterday", "caught_pokemon": map[string]any{}}
+	if err := migrateV2ToV3(raw); err == nil {
+		t.Error("Expected an error for an unparseable saved_at")
+	}
+}
+
+func TestMigrateV4ToV5FillsPartyThenBoxes(t *testing.T) {
+	var caught []any
+	for id := 40; id >= 1; id-- {
+		caught = append(caught, map[string]any{"id": float64(id)})
+	}
+	raw := map[string]any{"caught_pokemon": caught}
+	if err := migrateV4ToV5(raw); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	storage := raw["storage"].(map[string]any)
+	party := storage["party"].([]any)
+	boxes := storage["boxes"].([]any)
+	if len(party) != 6 || party[0] != 1 || party[5] != 6 {
+		t.Errorf("Expected IDs 1-6 in the party, got %v", party)
+	}
+	if len(boxes) != 8 {
+		t.Fatalf("Expected 8 boxes, got %d", len(boxes))
+	}
+	first, second := boxes[0].([]any), boxes[1].([]any)
+	if len(first) != 30 || first[0] != 7 || len(second) != 4 || second[3] != 40 {
+		t.Errorf("Expected IDs 7-36 in box 1 and 37-40 in box 2, got %v and %v", first, second)
+	}
+}
@@ -0,0 +1,257 @@
+package save
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/markcromwell/pokedexcli/internal/game"
+)
+
+// CurrentVersion is the version of the save format written by this build.
+const CurrentVersion = 9
+
+// DefaultProfile is the profile used when none is chosen.
+const DefaultProfile = "default"
+
+// appName is the directory save files are kept in, under the user data dir.
+const appName = "pokedexcli"
+
+// File is the on-disk state of a trainer profile's Pokedex.
+type File struct {
+	Version       int                   `json:"version"`
+	SavedAt       time.Time             `json:"saved_at"`
+	Profile       string                `json:"profile"`
+	CaughtPokemon []*game.CaughtPokemon `json:"caught_pokemon"`
+	NextID        int                   `json:"next_id"`
+	SpeciesCaught []string              `json:"species_caught"`
+	Storage       *game.Storage         `json:"storage"`
+	Bag           game.Bag              `json:"bag"`
+	Money         int                   `json:"money"`
+	Location      string                `json:"location"`
+	Settings      Settings              `json:"settings"`
+	History       []string              `json:"history"`
+}
+
+// Settings are the per-profile REPL preferences.
+type Settings struct {
+	Lang     string `json:"lang"`
+	Prefetch bool   `json:"prefetch"`
+}
+
+// DataDir returns the directory save files are kept in: $XDG_DATA_HOME/pokedexcli
+// if set, otherwise pokedexcli under the user's config dir.
+func DataDir() (string, error) {
+	if dir := os.Getenv("XDG_DATA_HOME"); dir != "" {
+		return filepath.Join(dir, appName), nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, appName), nil
+}
+
+// legacyPath returns the path of the single save file used before profiles existed.
+func legacyPath() (string, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "save.json"), nil
+}
+
+// profilesDir returns the directory holding one save file per profile.
+func profilesDir() (string, error) {
+	dir, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "profiles"), nil
+}
+
+// ValidateProfileName checks that name can be used as a profile's file name.
+func ValidateProfileName(name string) error {
+	if name == "" {
+		return fmt.Errorf("profile name cannot be empty")
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return fmt.Errorf("invalid profile name '%s': use letters, digits, '-' and '_'", name)
+		}
+	}
+	return nil
+}
+
+// ProfilePath returns the path of the save file for the named profile.
+// The default profile takes over the pre-profile save file if it has none yet.
+func ProfilePath(name string) (string, error) {
+	if err := ValidateProfileName(name); err != nil {
+		return "", err
+	}
+	dir, err := profilesDir()
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, name+".json")
+
+	if name == DefaultProfile {
+		if err := adoptLegacySave(path); err != nil {
+			return "", err
+		}
+	}
+	return path, nil
+}
+
+// adoptLegacySave moves the pre-profile save file to path, unless path already exists.
+func adoptLegacySave(path string) error {
+	legacy, err := legacyPath()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if _, err := os.Stat(legacy); err != nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.Rename(legacy, path)
+}
+
+// ListProfiles returns the names of all profiles with a save file, sorted.
+func ListProfiles() ([]string, error) {
+	dir, err := profilesDir()
+	if err != nil {
+		return nil, err
+	}
+	matches, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(matches))
+	for _, match := range matches {
+		names = append(names, strings.TrimSuffix(filepath.Base(match), ".json"))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// ProfileExists reports whether the named profile has a save file.
+func ProfileExists(name string) (bool, error) {
+	path, err := ProfilePath(name)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// DeleteProfile removes the named profile's save file.
+func DeleteProfile(name string) error {
+	path, err := ProfilePath(name)
+	if err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// Load reads the save file at path. Errors satisfy errors.Is(err, os.ErrNotExist)
+// when there is no save yet. Saves from older versions are upgraded through the
+// registered migrations; the original file is kept as <path>.v<N>.bak and the
+// upgraded save is written back to path.
+func Load(path string) (*File, error) {
+	file, data, from, err := read(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if from < CurrentVersion {
+		if err := writeBackup(path, from, data); err != nil {
+			return nil, fmt.Errorf("backing up save %s: %w", path, err)
+		}
+		savedAt := file.SavedAt
+		if err := Write(path, file); err != nil {
+			return nil, fmt.Errorf("writing upgraded save %s: %w", path, err)
+		}
+		file.SavedAt = savedAt
+	}
+	return file, nil
+}
+
+// Read reads the save file at path like Load, but upgrades saves from older
+// versions in memory only: the file itself is left untouched.
+func Read(path string) (*File, error) {
+	file, _, _, err := read(path)
+	return file, err
+}
+
+// read reads and upgrades the save file at path, returning the original data
+// and the version it was written with along with the upgraded save.
+func read(path string) (*File, []byte, int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	upgraded, from, err := migrate(data)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("reading save %s: %w", path, err)
+	}
+
+	var file File
+	err = json.Unmarshal(upgraded, &file)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("reading save %s: %w", path, err)
+	}
+	return &file, data, from, nil
+}
+
+// Write saves file to path atomically: it is written to a temporary file in
+// the same directory which then replaces path, so a crash never leaves a
+// half-written save behind.
+func Write(path string, file *File) error {
+	file.Version = CurrentVersion
+	file.SavedAt = time.Now()
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(path)
+	err = os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	// remove the temporary file if anything below fails; after the rename this is a no-op
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,111 @@
+package save
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/markcromwell/pokedexcli/internal/game"
+)
+
+func TestWriteAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "nested", "save.json")
+
+	pidgey := &game.CaughtPokemon{ID: 1, SpeciesID: 16, Name: "pidgey", Level: 7}
+	err := Write(path, &File{CaughtPokemon: []*game.CaughtPokemon{pidgey}, NextID: 2})
+	if err != nil {
+		t.Fatalf("Expected no error writing save, got: %v", err)
+	}
+
+	file, err := Load(path)
+	if err != nil {
+		t.Fatalf("Expected no error loading save, got: %v", err)
+	}
+	if file.Version != CurrentVersion {
+		t.Errorf("Expected version %d, got %d", CurrentVersion, file.Version)
+	}
+	if len(file.CaughtPokemon) != 1 || file.CaughtPokemon[0].SpeciesID != 16 || file.CaughtPokemon[0].Level != 7 || file.NextID != 2 {
+		t.Errorf("Expected pidgey to round-trip, got %+v", file.CaughtPokemon)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("Expected only the save file to be left behind, found %d entries", len(entries))
+	}
+}
+
+func TestLoadMissing(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "save.json"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected a not-exist error, got: %v", err)
+	}
+}
+
+func TestLoadNewerVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	err := os.WriteFile(path, []byte(`{"version": 999}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Expected an error loading a save from a newer version")
+	}
+}
+
+func TestProfiles(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+
+	legacy, err := legacyPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Write(legacy, &File{CaughtPokemon: []*game.CaughtPokemon{{ID: 1, Name: "pidgey"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the default profile takes over the pre-profile save
+	path, err := ProfilePath(DefaultProfile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := Load(path)
+	if err != nil {
+		t.Fatalf("Expected the legacy save to be adopted, got: %v", err)
+	}
+	if len(file.CaughtPokemon) != 1 || file.CaughtPokemon[0].Name != "pidgey" {
+		t.Errorf("Expected pidgey in the default profile, got %v", file.CaughtPokemon)
+	}
+
+	misty, err := ProfilePath("misty")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Write(misty, &File{Profile: "misty"}); err != nil {
+		t.Fatal(err)
+	}
+
+	profiles, err := ListProfiles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(profiles) != 2 || profiles[0] != DefaultProfile || profiles[1] != "misty" {
+		t.Errorf("Expected [default misty], got %v", profiles)
+	}
+
+	if err := DeleteProfile("misty"); err != nil {
+		t.Fatal(err)
+	}
+	if exists, _ := ProfileExists("misty"); exists {
+		t.Error("Expected misty to be deleted")
+	}
+
+	if _, err := ProfilePath("../escape"); err == nil {
+		t.Error("Expected an error for an invalid profile name")
+	}
+}
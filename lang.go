@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// nameIndex maps localized names back to PokeAPI slugs, per resource type
+// (e.g. "location-area", "pokemon-species", "move"). It is filled in as
+// resources are fetched so that names printed by the REPL can be typed back in.
+// PokeAPI's list endpoints only carry English slugs, so a localized name only
+// resolves once it has been shown this session, e.g. by map, explore or
+// species; English names and slugs always resolve.
+type nameIndex map[string]map[string]string
+
+// add records every localized name of the resource identified by slug.
+func (idx nameIndex) add(resource, slug string, names []pokeapi.Name) {
+	if idx[resource] == nil {
+		idx[resource] = make(map[string]string)
+	}
+	for _, n := range names {
+		idx[resource][strings.ToLower(n.Name)] = slug
+	}
+}
+
+// lookup finds the slug for a localized name typed as one or more words.
+// A species' localized name also names its default Pokemon, so "pokemon"
+// lookups fall back to "pokemon-species".
+func (idx nameIndex) lookup(resource string, words []string) (string, bool) {
+	name := strings.Join(words, " ")
+	if slug, found := idx[resource][name]; found {
+		return slug, true
+	}
+	if resource == "pokemon" {
+		slug, found := idx["pokemon-species"][name]
+		return slug, found
+	}
+	return "", false
+}
+
+// resolve turns user input into a slug. The words are looked up in the index;
+// if that fails the first word is used as-is.
+func (idx nameIndex) resolve(resource string, words []string) string {
+	if len(words) == 0 {
+		return ""
+	}
+	if slug, found := idx.lookup(resource, words); found {
+		return slug
+	}
+	return words[0]
+}
+
+// language returns the configured language, defaulting to English.
+func (cfg *config) language() string {
+	if cfg.lang == "" {
+		return pokeapi.DefaultLanguage
+	}
+	return cfg.lang
+}
+
+// localize returns the name of a resource in the configured language, falling
+// back to English and then to its slug. The names are added to the name index.
+func (cfg *config) localize(resource, slug string, names []pokeapi.Name) string {
+	if cfg.names == nil {
+		cfg.names = make(nameIndex)
+	}
+	cfg.names.add(resource, slug, names)
+
+	if name := pokeapi.LocalizedName(names, cfg.language()); name != "" {
+		return name
+	}
+	return slug
+}
+
+// pokemonName returns the name of a Pokemon in the configured language, taken
+// from the species of the same name. It falls back to the slug, and English
+// needs no lookup.
+func (cfg *config) pokemonName(slug string) string {
+	if cfg.language() == pokeapi.DefaultLanguage {
+		return slug
+	}
+	species, err := pokeapi.GetPokemonSpecies(slug)
+	if err != nil {
+		return slug
+	}
+	return cfg.localize("pokemon-species", species.Name, species.Names)
+}
+
+// resolveName resolves user input through the name index.
+func (cfg *config) resolveName(resource string, words []string) string {
+	return cfg.names.resolve(resource, words)
+}
+
+// commandLang takes an optional language code. Without one it prints the
+// current language; otherwise it switches names and flavor text to that
+// language. Localized names can be typed back in once they have been shown.
+// Pokedex > lang fr
+// Language set to fr (Français)
+func commandLang(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		fmt.Printf("Current language: %s\n", cfg.language())
+		return nil
+	}
+
+	language, err := pokeapi.GetLanguage(param[0])
+	if err != nil {
+		return fmt.Errorf("unknown language '%s': %v", param[0], err)
+	}
+
+	cfg.lang = language.Name
+	cfg.autosave()
+	fmt.Printf("Language set to %s (%s)\n", language.Name, pokeapi.LocalizedName(language.Names, language.Name))
+
+	return nil
+}
+
+// commandSpecies expects a species name and prints its localized name, genus
+// and flavor text.
+// Pokedex > species pikachu
+// Pikachu (#25) - Mouse Pokémon
+// Capture rate: 190
+// When several of these POKéMON gather, their electricity could build and cause lightning storms.
+func commandSpecies(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("please specify a species")
+	}
+
+	speciesName, err := cfg.resolveResource("pokemon-species", param)
+	if err != nil {
+		return err
+	}
+	species, err := pokeapi.GetPokemonSpecies(speciesName)
+	if err != nil {
+		return fmt.Errorf("could not find species '%s': %v", speciesName, err)
+	}
+
+	name := cfg.localize("pokemon-species", species.Name, species.Names)
+	fmt.Printf("%s (#%d) - %s\n", name, species.ID, species.Genus(cfg.language()))
+	fmt.Printf("Generation: %s\n", species.Generation.Name)
+	fmt.Printf("Capture rate: %d\n", species.CaptureRate)
+	fmt.Printf("Growth rate: %s\n", species.GrowthRate.Name)
+	if text := pokeapi.LocalizedFlavorText(species.FlavorTextEntries, cfg.language()); text != "" {
+		fmt.Println(text)
+	}
+
+	return nil
+}
+
+// commandMove expects a move name and prints its localized name, type, power,
+// accuracy, PP and flavor text.
+// Pokedex > move thunderbolt
+// Thunderbolt (electric, special)
+// Power: 90  Accuracy: 100  PP: 15
+// A strong electric blast crashes down on the target. This may also leave the target with paralysis.
+func commandMove(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("please specify a move")
+	}
+
+	moveName, err := cfg.resolveResource("move", param)
+	if err != nil {
+		return err
+	}
+	move, err := pokeapi.GetMove(moveName)
+	if err != nil {
+		return fmt.Errorf("could not find move '%s': %v", moveName, err)
+	}
+
+	name := cfg.localize("move", move.Name, move.Names)
+	fmt.Printf("%s (%s, %s)\n", name, move.Type.Name, move.DamageClass.Name)
+	fmt.Printf("Power: %s  Accuracy: %s  PP: %s\n", optionalInt(move.Power), optionalInt(move.Accuracy), optionalInt(move.PP))
+	if text := pokeapi.LocalizedFlavorText(move.FlavorTextEntries, cfg.language()); text != "" {
+		fmt.Println(text)
+	}
+
+	return nil
+}
+
+// optionalInt formats a nullable PokeAPI number, printing "-" for null.
+func optionalInt(n *int) string {
+	if n == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d", *n)
+}
@@ -2,20 +2,57 @@ package main
 
 import (
 	"bufio"
+	"context"
+	"flag"
 	"fmt"
 	"math/rand"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/markcromwell/pokedexcli/internal/game"
 	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+	"github.com/markcromwell/pokedexcli/internal/save"
 )
 
 type config struct {
 	nextURL        *string
 	prevURL        *string
-	caughtPokemons map[string]*pokeapi.Pokemon
+	caughtPokemons map[int]*game.CaughtPokemon
+	nextPokemonID  int
+	speciesCaught  map[string]bool
+	storage        *game.Storage
+	bag            game.Bag
+	money          int
+	location       string
+	wild           *wildEncounter
+	input          *bufio.Scanner
+	lang           string
+	names          nameIndex
+	savePath       string
+	profile        string
+	history        []string
+
+	prefetchEnabled bool
+	cancelPrefetch  context.CancelFunc
+
+	// rawArgs are the current command's parameters in their original case;
+	// the parameters passed to callbacks are lowercased.
+	rawArgs []string
+
+	// growthCurves caches experience curves by growth rate name, and
+	// speciesGrowthRates the growth rate name of each species looked up.
+	growthCurves       map[string]game.GrowthCurve
+	speciesGrowthRates map[string]string
+
+	rng  *rand.Rand
+	seed int64
 }
 
 func commandExit(commands map[string]cliCommand, cfg *config, param []string) error {
+	cfg.autosave()
 	fmt.Println("\nClosing the Pokedex... Goodbye!")
 	os.Exit(0)
 
@@ -35,6 +72,28 @@ Commands:`)
 	return nil
 }
 
+// printLocationAreas prints a page of location areas. When a language other
+// than English is set, each area is fetched to print its localized name.
+// The areas and the next page are then prefetched.
+func printLocationAreas(cfg *config, locations *pokeapi.LocationData) {
+	var urls []string
+	if locations.Next != nil && *locations.Next != "" {
+		urls = append(urls, *locations.Next)
+	}
+	defer func() { cfg.prefetch(urls) }()
+
+	for _, loc := range locations.Results {
+		urls = append(urls, pokeapi.ResourceURL("location-area", loc.Name))
+		name := loc.Name
+		if cfg.language() != pokeapi.DefaultLanguage {
+			if area, err := pokeapi.GetLocationArea(loc.Name); err == nil {
+				name = cfg.localize("location-area", area.Name, area.Names)
+			}
+		}
+		fmt.Printf("%s\n", name)
+	}
+}
+
 func commandMap(commands map[string]cliCommand, cfg *config, param []string) error {
 	if cfg.nextURL == nil || *cfg.nextURL == "" {
 		cfg.nextURL = new(string)
@@ -46,9 +105,7 @@ func commandMap(commands map[string]cliCommand, cfg *config, param []string) err
 		return err
 	}
 
-	for _, loc := range locations.Results {
-		fmt.Printf("%s\n", loc.Name)
-	}
+	printLocationAreas(cfg, locations)
 
 	prevURL := cfg.nextURL
 	cfg.nextURL = locations.Next
@@ -68,78 +125,187 @@ func commandMapb(commands map[string]cliCommand, cfg *config, param []string) er
 		return err
 	}
 
-	for _, loc := range locations.Results {
-		fmt.Printf("%s\n", loc.Name)
-	}
+	printLocationAreas(cfg, locations)
 
 	cfg.nextURL = locations.Next
 	cfg.prevURL = locations.Previous
 	return nil
 }
 
+// commandExplore expects a location area name, defaulting to the current one,
+// plus optional --version, --method and --time flags. It prints an encounter
+// table with the level range and chance of every Pokemon found there at that
+// time of day, followed by the encounter rate of each method.
 func commandExplore(commands map[string]cliCommand, cfg *config, param []string) error {
-	if len(param) == 0 {
-		return fmt.Errorf("please specify a location to explore")
+	args, flags := parseArgs(param)
+	if len(args) == 0 {
+		if cfg.location == "" {
+			return fmt.Errorf("please specify a location to explore")
+		}
+		args = []string{cfg.location}
+	}
+	version := flags["version"]
+	method := flags["method"]
+	timeOfDay, err := encounterTime(flags)
+	if err != nil {
+		return err
 	}
 
-	locationArea, err := pokeapi.GetLocationArea(param[0])
+	areaName, err := cfg.resolveResource("location-area", args)
+	if err != nil {
+		return err
+	}
+	locationArea, err := pokeapi.GetLocationArea(areaName)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("Exploring %s...\n", locationArea.Name)
+	fmt.Printf("Exploring %s...\n", cfg.localize("location-area", locationArea.Name, locationArea.Names))
 	fmt.Printf("Found Pokemon:\n")
+	cfg.printEncounterTable(locationArea.PokemonEncounters, version, method, timeOfDay)
+	printEncounterRates(locationArea.EncounterMethodRates, version, method)
+
+	var urls []string
 	for _, encounter := range locationArea.PokemonEncounters {
-		fmt.Printf(" - %s\n", encounter.Pokemon.Name)
+		urls = append(urls, pokeapi.ResourceURL("pokemon", encounter.Pokemon.Name))
 	}
+	cfg.prefetch(urls)
 
 	return nil
 }
 
-// commandCatch expects only 1 param, the pokemon name to catch. it uses the client.go GetPokemon to see if the pokemon exists, returns an error if it doesn't. then pritns "Throwing a Pokeball at %s... where %s is the name of the Pokemon. A percentage chance will be used at catching the pokemon will be calculated based on base experience (higher the harder). If the roll suceeds a map of Pokemon index by name will be used to store the Pokemon. Also should check if the Pokemon is already caught before trying to catch again."
+// commandCatch expects the name or ID of the pokemon to catch, or nothing to catch the wild Pokemon currently encountered. it uses the client.go GetPokemon to see if the pokemon exists, returns an error if it doesn't. then prints "Throwing a Poke Ball at %s..." where %s is the name of the Pokemon. The ball (--ball, a Poke Ball by default) is taken from the bag. The chance to catch it uses the Gen III formula with the species capture rate, its remaining HP (--hp, a percentage, or what is left of a wild Pokemon's HP after battle), the ball and its status (--status), and each passed shake check prints a wobble. Only Pokemon found in the current location area can be caught. If the throw succeeds the Pokemon is stored as a new individual with its own ID, so the same species can be caught more than once. No ball is thrown while the party and every PC box are full.
 func commandCatch(commands map[string]cliCommand, cfg *config, param []string) error {
-	if len(param) == 0 {
+	args, flags := parseArgs(param)
+	wild := cfg.wild
+	if len(args) == 0 && wild == nil {
 		return fmt.Errorf("please specify a Pokemon to catch")
 	}
-
-	// check if already caught
-	if _, caught := cfg.caughtPokemons[param[0]]; caught {
-		fmt.Printf("You have already caught %s.\n", param[0])
-		return nil
+	if len(args) > 0 {
+		wild = nil
 	}
 
-	pokemonName := param[0]
-	pokemon, err := pokeapi.GetPokemon(pokemonName)
+	ballName := game.DefaultBall
+	if value, ok := flags["ball"]; ok {
+		ballName = value
+	}
+	ball, err := game.LookupBall(ballName)
 	if err != nil {
-		return fmt.Errorf("could not find Pokemon '%s': %v", pokemonName, err)
+		return err
+	}
+	if cfg.bag.Count(ballName) == 0 {
+		return fmt.Errorf("you don't have any %ss left", ball.Name)
+	}
+	if cfg.storage != nil && !cfg.storage.HasRoom() {
+		return fmt.Errorf("your party and all PC boxes are full, release a Pokemon first")
 	}
 
-	fmt.Printf("Throwing a Pokeball at %s...\n", pokemon.Name)
+	status, err := game.StatusBonus(flags["status"])
+	if err != nil {
+		return err
+	}
 
-	// Simple catch chance calculation based on base experience
-	catchChance := 50.0 - (float64(pokemon.BaseExperience) / 10.0)
-	if catchChance < 5.0 {
-		catchChance = 5.0 // Minimum 5% chance
+	hpFraction := 1.0
+	if wild != nil {
+		hpFraction = wild.hpFraction()
+	}
+	if value, ok := flags["hp"]; ok {
+		percent, err := strconv.Atoi(strings.TrimSuffix(value, "%"))
+		if err != nil || percent < 1 || percent > 100 {
+			return fmt.Errorf("--hp must be a percentage between 1 and 100, got '%s'", value)
+		}
+		hpFraction = float64(percent) / 100
 	}
 
-	roll := float64(rand.Intn(100))
-	if roll < catchChance {
-		if cfg.caughtPokemons == nil {
-			cfg.caughtPokemons = make(map[string]*pokeapi.Pokemon)
+	var pokemon *pokeapi.Pokemon
+	if wild != nil {
+		pokemon = wild.pokemon
+	} else {
+		area, err := cfg.currentArea()
+		if err != nil {
+			return err
+		}
+
+		pokemonName, err := cfg.resolveResource("pokemon", args)
+		if err != nil {
+			return err
+		}
+
+		pokemon, err = pokeapi.GetPokemon(pokemonName)
+		if err != nil {
+			return fmt.Errorf("could not find Pokemon '%s': %v", pokemonName, err)
+		}
+
+		if !foundIn(area, pokemon.Name) {
+			return fmt.Errorf("there are no %s in %s, use 'where %s' to find some", pokemon.Name, area.Name, pokemon.Name)
 		}
+	}
 
-		cfg.caughtPokemons[pokemon.Name] = pokemon
-		fmt.Printf("Congratulations! You caught %s!\n", pokemon.Name)
-		fmt.Printf("You may now inspect it with the inspect command.\n")
+	species, err := pokeapi.GetPokemonSpecies(pokemon.Species.Name)
+	if err != nil {
+		return fmt.Errorf("could not get species '%s': %v", pokemon.Species.Name, err)
+	}
+
+	// the record is rolled before the ball leaves the bag, so a failed lookup
+	// doesn't cost a ball
+	var caught *game.CaughtPokemon
+	if wild != nil {
+		caught = wild.individual
 	} else {
-		fmt.Printf("Oh no! %s escaped the Pokeball!\n", pokemon.Name)
+		caught, err = cfg.newCaughtPokemon(pokemon, game.DefaultLevel)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := cfg.bag.Remove(ballName, 1); err != nil {
+		return err
+	}
+	fmt.Printf("Throwing a %s at %s... (%d left)\n", ball.Name, pokemon.Name, cfg.bag.Count(ballName))
+
+	a := game.CatchValue(species.CaptureRate, hpFraction, ball.Multiplier, status)
+	result := game.Throw(cfg.rng, a, len(cfg.speciesCaught))
+	if result.Critical {
+		fmt.Println("A critical capture!")
+	}
+	if result.Shakes > 0 {
+		fmt.Println(strings.TrimSpace(strings.Repeat("wobble... ", result.Shakes)))
+	}
+
+	if !result.Caught {
+		cfg.autosave()
+		fmt.Printf("Oh no! %s escaped the %s!\n", pokemon.Name, ball.Name)
+		return nil
+	}
+
+	if wild != nil {
+		caught.CaughtAt = time.Now()
+		cfg.wild = nil
+	}
+
+	newSpecies := !cfg.speciesCaught[caught.Species]
+	box, err := cfg.addCaught(caught)
+	if err != nil {
+		return err
+	}
+	reward := game.CatchReward(caught.Level)
+	cfg.money += reward
+	cfg.autosave()
+	fmt.Printf("Congratulations! You caught %s! (ID %d)\n", pokemon.Name, caught.ID)
+	fmt.Printf("You earned $%d.\n", reward)
+	if box > 0 {
+		fmt.Printf("Your party is full, %s was sent to Box %d.\n", pokemon.Name, box)
 	}
+	if newSpecies {
+		fmt.Printf("%s was added to your Pokedex.\n", caught.Species)
+	}
+	fmt.Printf("You may now inspect it with the inspect command.\n")
 
 	return nil
 }
 
 /*
-	commandInspect takes the name of a Pokemon and prints the name, height, weight, stats and type(s) of the Pokemon. Example usage:
+	commandInspect takes the instance ID, #dex number or name of an owned Pokemon and prints its name, level and experience, nature, height, weight, stats and type(s). Height, weight and the experience curve are looked up from PokeAPI; the rest comes from the caught record. Example usage:
 
 For example:
 Pokedex > inspect pidgey
@@ -148,17 +314,22 @@ Pokedex > catch pidgey
 Throwing a Pokeball at pidgey...
 pidgey was caught!
 Pokedex > inspect pidgey
+ID: 1
 Name: pidgey
+Level: 5
+EXP: 135 (44 to level 6)
+Nature: adamant
+Caught: 2025-10-19 15:04
 Height: 3
 Weight: 18
 Stats:
 
-	-hp: 40
-	-attack: 45
-	-defense: 40
-	-special-attack: 35
-	-special-defense: 35
-	-speed: 56
+	-hp: 19 (IV 12)
+	-attack: 11 (IV 25)
+	-defense: 9 (IV 3)
+	-special-attack: 8 (IV 14)
+	-special-defense: 8 (IV 9)
+	-speed: 12 (IV 30)
 
 Types:
   - normal
@@ -169,48 +340,133 @@ func commandInspect(commands map[string]cliCommand, cfg *config, param []string)
 		return fmt.Errorf("please specify a Pokemon to inspect")
 	}
 
-	pokemonName := param[0]
-	pokemon, caught := cfg.caughtPokemons[pokemonName]
-	if !caught {
+	pokemon, matches := cfg.findOwned(param)
+	if pokemon == nil {
 		fmt.Printf("You have not caught that pokemon\n")
 		return nil
 	}
+	if len(matches) > 1 {
+		ids := make([]string, len(matches))
+		for i, m := range matches {
+			ids[i] = strconv.Itoa(m.ID)
+		}
+		fmt.Printf("You own %d of those (IDs %s), showing ID %d. Use inspect <id> for another.\n", len(matches), strings.Join(ids, ", "), pokemon.ID)
+	}
 
-	fmt.Printf("Name: %s\n", pokemon.Name)
-	fmt.Printf("Height: %d\n", pokemon.Height)
-	fmt.Printf("Weight: %d\n", pokemon.Weight)
+	// the caught record is compact; the species and full details come from PokeAPI
+	name := pokemon.Name
+	species, speciesErr := pokeapi.GetPokemonSpecies(pokemon.Species)
+	if speciesErr == nil {
+		name = cfg.localize("pokemon-species", species.Name, species.Names)
+	}
+
+	fmt.Printf("ID: %d\n", pokemon.ID)
+	fmt.Printf("Name: %s\n", name)
+	if pokemon.Nickname != "" {
+		fmt.Printf("Nickname: %s\n", pokemon.Nickname)
+	}
+	if pokemon.Favorite {
+		fmt.Printf("Favorite: yes\n")
+	}
+	if len(pokemon.Tags) > 0 {
+		fmt.Printf("Tags: %s\n", strings.Join(pokemon.Tags, ", "))
+	}
+	fmt.Printf("Level: %d\n", pokemon.Level)
+	exp := pokemon.Exp
+	curve, curveErr := cfg.growthCurve(pokemon.Species)
+	if curveErr == nil {
+		// saves migrated to v9 start at 0 EXP, which GainExp raises to the
+		// minimum for the level
+		exp = max(exp, curve.ExpForLevel(pokemon.Level))
+	}
+	if curveErr == nil && pokemon.Level < game.MaxLevel {
+		fmt.Printf("EXP: %d (%d to level %d)\n", exp, curve.ExpForLevel(pokemon.Level+1)-exp, pokemon.Level+1)
+	} else {
+		fmt.Printf("EXP: %d\n", exp)
+	}
+	fmt.Printf("Nature: %s\n", pokemon.Nature)
+	if pokemon.Location != "" {
+		fmt.Printf("Caught: %s in %s\n", pokemon.CaughtAt.Format("2006-01-02 15:04"), pokemon.Location)
+	} else {
+		fmt.Printf("Caught: %s\n", pokemon.CaughtAt.Format("2006-01-02 15:04"))
+	}
+	if details, err := pokeapi.GetPokemon(pokemon.Name); err == nil {
+		fmt.Printf("Height: %d\n", details.Height)
+		fmt.Printf("Weight: %d\n", details.Weight)
+	}
 	fmt.Printf("Stats:\n")
-	for _, stat := range pokemon.Stats {
-		fmt.Printf("  - %s: %d\n", stat.Stat.Name, stat.BaseStat)
+	for _, stat := range game.StatNames {
+		fmt.Printf("  - %s: %d (IV %d)\n", stat, pokemon.Stats.Get(stat), pokemon.IVs.Get(stat))
 	}
 	fmt.Printf("Types:\n")
 	for _, t := range pokemon.Types {
-		fmt.Printf("  - %s\n", t.Type.Name)
+		fmt.Printf("  - %s\n", t)
+	}
+	if speciesErr == nil {
+		if text := pokeapi.LocalizedFlavorText(species.FlavorTextEntries, cfg.language()); text != "" {
+			fmt.Println(text)
+		}
 	}
 
 	return nil
 }
 
-// commandPokedex takes no parameters and lists all caught Pokemon by name.
+// commandPokedex takes no parameters and lists every species caught, with
+// the IDs of the individuals owned of each.
+// Nicknames, favorites (*) and tags are shown next to each ID.
 // Pokedex > pokedex
-//Your Pokedex:
-// - pidgey
-// - caterpie
+//Your Pokedex: 2 species caught, 3 Pokemon owned
+// - caterpie (ID 2)
+// - pidgey (IDs 1 "birdy" * [starter], 3)
 
 func commandPokedex(commands map[string]cliCommand, cfg *config, param []string) error {
-	if len(cfg.caughtPokemons) == 0 {
+	if len(cfg.speciesCaught) == 0 {
 		fmt.Println("You have not caught any Pokemon yet.")
 		return nil
 	}
 
-	fmt.Println("Your Pokedex:")
-	for name := range cfg.caughtPokemons {
-		fmt.Printf(" - %s\n", name)
+	owned := map[string][]string{}
+	for _, pokemon := range cfg.ownedPokemon() {
+		owned[pokemon.Species] = append(owned[pokemon.Species], describeOwned(pokemon))
+	}
+
+	species := make([]string, 0, len(cfg.speciesCaught))
+	for name := range cfg.speciesCaught {
+		species = append(species, name)
+	}
+	sort.Strings(species)
+
+	fmt.Printf("Your Pokedex: %d species caught, %d Pokemon owned\n", len(species), len(cfg.caughtPokemons))
+	for _, name := range species {
+		switch ids := owned[name]; len(ids) {
+		case 0:
+			fmt.Printf(" - %s (none owned)\n", name)
+		case 1:
+			fmt.Printf(" - %s (ID %s)\n", name, ids[0])
+		default:
+			fmt.Printf(" - %s (IDs %s)\n", name, strings.Join(ids, ", "))
+		}
 	}
 
 	return nil
 }
 
+// describeOwned formats an owned Pokemon's ID with its nickname, favorite
+// marker and tags, e.g. `1 "birdy" * [starter]`.
+func describeOwned(pokemon *game.CaughtPokemon) string {
+	description := strconv.Itoa(pokemon.ID)
+	if pokemon.Nickname != "" {
+		description += fmt.Sprintf(" %q", pokemon.Nickname)
+	}
+	if pokemon.Favorite {
+		description += " *"
+	}
+	if len(pokemon.Tags) > 0 {
+		description += " [" + strings.Join(pokemon.Tags, ", ") + "]"
+	}
+	return description
+}
+
 type cliCommand struct {
 	name        string
 	description string
@@ -240,29 +496,243 @@ var commands = map[string]cliCommand{
 	},
 	"explore": {
 		name:        "explore",
-		description: "Explores the specified location",
+		description: "Explores the specified location: explore [area] [--version x] [--method walk|surf|old-rod...] [--time morning|day|night]",
 		callback:    commandExplore,
 	},
 	"catch": {
 		name:        "catch",
-		description: "Catch a Pokemon (not implemented)",
+		description: "Catch a Pokemon, or the wild one you encountered: catch [name|id] [--ball poke-ball] [--hp percent] [--status sleep|paralysis|...]",
 		callback:    commandCatch,
 	},
 	"inspect": {
 		name:        "inspect",
-		description: "Inspect a caught Pokemon",
+		description: "Inspect an owned Pokemon: inspect <id|#dex|name>",
 		callback:    commandInspect,
 	},
 	"pokedex": {
 		name:        "pokedex",
-		description: "List all caught Pokemon",
+		description: "Lists the species caught and the Pokemon owned",
 		callback:    commandPokedex,
 	},
+	"regions": {
+		name:        "regions",
+		description: "Lists all regions",
+		callback:    commandRegions,
+	},
+	"region": {
+		name:        "region",
+		description: "Lists the locations in a region: region <name>",
+		callback:    commandRegion,
+	},
+	"location": {
+		name:        "location",
+		description: "Lists the areas in a location: location <name>",
+		callback:    commandLocation,
+	},
+	"progress": {
+		name:        "progress",
+		description: "Shows Pokedex completion: progress [pokedex]",
+		callback:    commandProgress,
+	},
+	"nature": {
+		name:        "nature",
+		description: "Shows the stat changes and flavors of a nature: nature <name>",
+		callback:    commandNature,
+	},
+	"lang": {
+		name:        "lang",
+		description: "Shows or sets the language for names and flavor text: lang [code]",
+		callback:    commandLang,
+	},
+	"species": {
+		name:        "species",
+		description: "Shows a species' name, genus and flavor text: species <name>",
+		callback:    commandSpecies,
+	},
+	"move": {
+		name:        "move",
+		description: "Shows a move's details and flavor text: move <name>",
+		callback:    commandMove,
+	},
+	"dex": {
+		name:        "dex",
+		description: "Lists Pokemon by National Dex number: dex <id|range>, e.g. dex 1-151",
+		callback:    commandDex,
+	},
+	"prefetch": {
+		name:        "prefetch",
+		description: "Turns background prefetching of likely next pages on or off: prefetch [on|off]",
+		callback:    commandPrefetch,
+	},
+	"save": {
+		name:        "save",
+		description: "Saves your Pokedex",
+		callback:    commandSave,
+	},
+	"load": {
+		name:        "load",
+		description: "Restores your Pokedex from a save file: load <file>",
+		callback:    commandLoad,
+	},
+	"new-game": {
+		name:        "new-game",
+		description: "Starts over with an empty Pokedex, backing up the current save",
+		callback:    commandNewGame,
+	},
+	"profile": {
+		name:        "profile",
+		description: "Manages trainer profiles: profile [list|create <name>|switch <name>|delete <name>]",
+		callback:    commandProfile,
+	},
+	"history": {
+		name:        "history",
+		description: "Lists the commands run in this profile",
+		callback:    commandHistory,
+	},
+	"party": {
+		name:        "party",
+		description: "Lists the Pokemon in your party",
+		callback:    commandParty,
+	},
+	"box": {
+		name:        "box",
+		description: "Lists the Pokemon in a PC box: box [n]",
+		callback:    commandBox,
+	},
+	"deposit": {
+		name:        "deposit",
+		description: "Moves a party Pokemon to the PC: deposit <id>",
+		callback:    commandDeposit,
+	},
+	"withdraw": {
+		name:        "withdraw",
+		description: "Moves a Pokemon from the PC to your party: withdraw <id>",
+		callback:    commandWithdraw,
+	},
+	"swap": {
+		name:        "swap",
+		description: "Swaps the places of two Pokemon in your party or PC: swap <id> <id>",
+		callback:    commandSwap,
+	},
+	"release": {
+		name:        "release",
+		description: "Releases an owned Pokemon, after confirmation: release <name|id>",
+		callback:    commandRelease,
+	},
+	"nickname": {
+		name:        "nickname",
+		description: "Sets or clears a Pokemon's nickname: nickname <id> [name]",
+		callback:    commandNickname,
+	},
+	"tag": {
+		name:        "tag",
+		description: "Adds or removes a tag on a Pokemon: tag <id> <label>",
+		callback:    commandTag,
+	},
+	"favorite": {
+		name:        "favorite",
+		description: "Marks or unmarks a Pokemon as a favorite: favorite <id>",
+		callback:    commandFavorite,
+	},
+	"seed": {
+		name:        "seed",
+		description: "Show the random seed, or reseed to replay a session: seed [number]",
+		callback:    commandSeed,
+	},
+	"bag": {
+		name:        "bag",
+		description: "List the items in your bag",
+		callback:    commandBag,
+	},
+	"shop": {
+		name:        "shop",
+		description: "List the items sold in the Poke Mart and their prices",
+		callback:    commandShop,
+	},
+	"buy": {
+		name:        "buy",
+		description: "Buy items from the Poke Mart: buy <item> [qty]",
+		callback:    commandBuy,
+	},
+	"sell": {
+		name:        "sell",
+		description: "Sell items from your bag for half their price: sell <item> [qty]",
+		callback:    commandSell,
+	},
+	"travel": {
+		name:        "travel",
+		description: "Travel to a location area: travel <location-area>",
+		callback:    commandTravel,
+	},
+	"whereami": {
+		name:        "whereami",
+		description: "Show the location area you are in",
+		callback:    commandWhereami,
+	},
+	"encounter": {
+		name:        "encounter",
+		description: "Look for a wild Pokemon in the current area: encounter [--method walk] [--version x] [--time morning|day|night]",
+		callback:    commandEncounter,
+	},
+	"walk": {
+		name:        "walk",
+		description: "Walk through the grass looking for a wild Pokemon: walk [--version x] [--time morning|day|night]",
+		callback:    commandWalk,
+	},
+	"surf": {
+		name:        "surf",
+		description: "Surf the water looking for a wild Pokemon: surf [--version x] [--time morning|day|night]",
+		callback:    commandSurf,
+	},
+	"fish": {
+		name:        "fish",
+		description: "Fish for a wild Pokemon: fish <old|good|super> [--version x] [--time morning|day|night]",
+		callback:    commandFish,
+	},
+	"battle": {
+		name:        "battle",
+		description: "Fight a round against the wild Pokemon with your party lead",
+		callback:    commandBattle,
+	},
+	"run": {
+		name:        "run",
+		description: "Run away from the wild Pokemon",
+		callback:    commandRun,
+	},
+	"where": {
+		name:        "where",
+		description: "Shows where a Pokemon can be caught: where <pokemon> [--version x] [--time morning|day|night]",
+		callback:    commandWhere,
+	},
 }
 
 func main() {
+	lang := flag.String("lang", pokeapi.DefaultLanguage, "language for names and flavor text (e.g. fr, de, ja)")
+	prefetch := flag.Bool("prefetch", false, "warm the cache in the background for likely next commands")
+	profile := flag.String("profile", save.DefaultProfile, "trainer profile to play as")
+	seed := flag.Int64("seed", 0, "seed for the random number generator, to replay a session exactly")
+	flag.Parse()
+
 	scanner := bufio.NewScanner(os.Stdin)
-	var cfg config
+	cfg := config{names: make(nameIndex), input: scanner}
+	cfg.reseed(randomSeed())
+
+	if err := cfg.switchProfile(*profile); err != nil {
+		fmt.Printf("Error loading profile '%s': %v\n", *profile, err)
+		os.Exit(1)
+	}
+
+	// flags given on the command line override the profile's settings
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "lang":
+			cfg.lang = *lang
+		case "prefetch":
+			cfg.prefetchEnabled = *prefetch
+		case "seed":
+			cfg.reseed(*seed)
+		}
+	})
 
 	for fmt.Print("Pokedex > "); scanner.Scan(); fmt.Print("Pokedex > ") {
 		command := scanner.Text()
@@ -272,9 +742,12 @@ func main() {
 		if len(input) == 0 {
 			continue
 		}
+		cfg.recordHistory(strings.Join(input, " "))
 
 		cmd, exists := commands[input[0]]
 		if exists {
+			cfg.stopPrefetch()
+			cfg.rawArgs = rawInput(command)[1:]
 			err := cmd.callback(commands, &cfg, input[1:])
 			if err != nil {
 				fmt.Printf("Error executing command '%s': %v\n", input[0], err)
@@ -284,4 +757,6 @@ func main() {
 		}
 	}
 
+	// input ended, e.g. with Ctrl-D, without the exit command
+	cfg.autosave()
 }
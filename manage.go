@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// confirm asks a yes/no question on the REPL input and reports whether the
+// answer was yes. Without input to read from, the answer is no.
+func (cfg *config) confirm(question string) bool {
+	if cfg.input == nil {
+		return false
+	}
+
+	fmt.Printf("%s [y/N] ", question)
+	if !cfg.input.Scan() {
+		return false
+	}
+	answer := strings.ToLower(strings.TrimSpace(cfg.input.Text()))
+	return answer == "y" || answer == "yes"
+}
+
+// commandRelease expects the ID or name of an owned Pokemon and, once
+// confirmed, releases it. Its species stays caught in the Pokedex. The last
+// Pokemon in the party can't be released.
+// Pokedex > release 3
+// Release pidgey (ID 3, Lv 5)? This cannot be undone. [y/N] y
+// pidgey was released. Bye-bye, pidgey!
+func commandRelease(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("please specify the Pokemon to release")
+	}
+
+	pokemon, matches := cfg.findOwned(param)
+	if pokemon == nil {
+		return fmt.Errorf("you don't own that Pokemon")
+	}
+	if len(matches) > 1 {
+		ids := make([]string, len(matches))
+		for i, m := range matches {
+			ids[i] = describeOwned(m)
+		}
+		return fmt.Errorf("you own %d of those, release one by ID: %s", len(matches), strings.Join(ids, ", "))
+	}
+
+	if box, _ := cfg.storage.Locate(pokemon.ID); box == 0 && len(cfg.storage.Party) == 1 {
+		return fmt.Errorf("you can't release the last Pokemon in your party")
+	}
+
+	question := fmt.Sprintf("Release %s (ID %d, Lv %d)? This cannot be undone.", pokemon.DisplayName(), pokemon.ID, pokemon.Level)
+	if !cfg.confirm(question) {
+		fmt.Println("Release cancelled.")
+		return nil
+	}
+
+	cfg.storage.Remove(pokemon.ID)
+	delete(cfg.caughtPokemons, pokemon.ID)
+	cfg.autosave()
+	fmt.Printf("%s was released. Bye-bye, %s!\n", pokemon.DisplayName(), pokemon.DisplayName())
+	return nil
+}
+
+// commandNickname expects a Pokemon ID and a nickname, kept in the case it is
+// typed in; without a nickname the current one is cleared.
+// Pokedex > nickname 1 Birdy
+// pidgey is now called Birdy.
+func commandNickname(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("please specify a Pokemon ID and a nickname")
+	}
+	pokemon, err := cfg.parseInstanceID(param[0])
+	if err != nil {
+		return err
+	}
+
+	words := make([]string, 0, len(param)-1)
+	for i := 1; i < len(param); i++ {
+		words = append(words, cfg.rawArg(param, i))
+	}
+	nickname := strings.Join(words, " ")
+	pokemon.Nickname = nickname
+	cfg.autosave()
+	if nickname == "" {
+		fmt.Printf("%s's nickname was cleared.\n", pokemon.Name)
+	} else {
+		fmt.Printf("%s is now called %s.\n", pokemon.Name, nickname)
+	}
+	return nil
+}
+
+// commandTag expects a Pokemon ID and a label, and adds the label to the
+// Pokemon's tags, or removes it if already there.
+// Pokedex > tag 1 starter
+// Tagged birdy with starter.
+func commandTag(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) < 2 {
+		return fmt.Errorf("please specify a Pokemon ID and a label")
+	}
+	pokemon, err := cfg.parseInstanceID(param[0])
+	if err != nil {
+		return err
+	}
+
+	label := strings.Join(param[1:], "-")
+	if pokemon.ToggleTag(label) {
+		fmt.Printf("Tagged %s with %s.\n", pokemon.DisplayName(), label)
+	} else {
+		fmt.Printf("Removed tag %s from %s.\n", label, pokemon.DisplayName())
+	}
+	cfg.autosave()
+	return nil
+}
+
+// commandFavorite expects a Pokemon ID and marks it as a favorite, or unmarks
+// it if it already is one.
+// Pokedex > favorite 1
+// birdy is now a favorite.
+func commandFavorite(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("please specify a Pokemon ID")
+	}
+	pokemon, err := cfg.parseInstanceID(param[0])
+	if err != nil {
+		return err
+	}
+
+	pokemon.Favorite = !pokemon.Favorite
+	cfg.autosave()
+	if pokemon.Favorite {
+		fmt.Printf("%s is now a favorite.\n", pokemon.DisplayName())
+	} else {
+		fmt.Printf("%s is no longer a favorite.\n", pokemon.DisplayName())
+	}
+	return nil
+}
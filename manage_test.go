@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/markcromwell/pokedexcli/internal/game"
+)
+
+// ownedConfig returns a config owning a pidgey for each ID, placed in the
+// party first and then the PC, answering prompts with input.
+func ownedConfig(input string, ids ...int) *config {
+	cfg := &config{
+		caughtPokemons: make(map[int]*game.CaughtPokemon),
+		speciesCaught:  map[string]bool{"pidgey": true},
+		storage:        game.NewStorage(),
+		input:          bufio.NewScanner(strings.NewReader(input)),
+	}
+	for _, id := range ids {
+		cfg.caughtPokemons[id] = &game.CaughtPokemon{ID: id, Name: "pidgey", Species: "pidgey", Level: 5}
+		cfg.storage.Place(id)
+	}
+	return cfg
+}
+
+func TestCommandRelease(t *testing.T) {
+	cases := []struct {
+		name          string
+		owned         []int
+		input         string
+		param         []string
+		wantErr       bool
+		expectedOwned []int
+	}{
+		{name: "confirmed", owned: []int{1, 2}, input: "y\n", param: []string{"2"}, expectedOwned: []int{1}},
+		{name: "cancelled", owned: []int{1, 2}, input: "n\n", param: []string{"2"}, expectedOwned: []int{1, 2}},
+		{name: "last in party", owned: []int{1}, input: "y\n", param: []string{"1"}, wantErr: true, expectedOwned: []int{1}},
+		{name: "not owned", owned: []int{1, 2}, input: "y\n", param: []string{"9"}, wantErr: true, expectedOwned: []int{1, 2}},
+		{name: "ambiguous name", owned: []int{1, 2}, input: "y\n", param: []string{"pidgey"}, wantErr: true, expectedOwned: []int{1, 2}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cfg := ownedConfig(c.input, c.owned...)
+			err := commandRelease(nil, cfg, c.param)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("Expected error %v, got: %v", c.wantErr, err)
+			}
+
+			var owned []int
+			for _, pokemon := range cfg.ownedPokemon() {
+				owned = append(owned, pokemon.ID)
+			}
+			if !reflect.DeepEqual(owned, c.expectedOwned) {
+				t.Errorf("Expected to own %v, got %v", c.expectedOwned, owned)
+			}
+			if !reflect.DeepEqual(cfg.storage.Party, c.expectedOwned) {
+				t.Errorf("Expected party %v, got %v", c.expectedOwned, cfg.storage.Party)
+			}
+			if !cfg.speciesCaught["pidgey"] {
+				t.Error("Expected the species to stay caught")
+			}
+		})
+	}
+}
+
+func TestCommandNickname(t *testing.T) {
+	cases := []struct {
+		name     string
+		command  string
+		expected string
+		wantErr  bool
+	}{
+		{name: "keeps case", command: "nickname 1 Sir Birdy", expected: "Sir Birdy"},
+		{name: "clears", command: "nickname 1", expected: ""},
+		{name: "not an id", command: "nickname pidgey Birdy", expected: "old", wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cfg := ownedConfig("", 1)
+			cfg.caughtPokemons[1].Nickname = "old"
+			cfg.rawArgs = rawInput(c.command)[1:]
+
+			err := commandNickname(nil, cfg, cleanInput(c.command)[1:])
+			if (err != nil) != c.wantErr {
+				t.Fatalf("Expected error %v, got: %v", c.wantErr, err)
+			}
+			if actual := cfg.caughtPokemons[1].Nickname; actual != c.expected {
+				t.Errorf("Expected nickname %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCommandTag(t *testing.T) {
+	cases := []struct {
+		name     string
+		tags     []string
+		param    []string
+		expected []string
+		wantErr  bool
+	}{
+		{name: "adds", param: []string{"1", "starter"}, expected: []string{"starter"}},
+		{name: "joins words", param: []string{"1", "for", "trade"}, expected: []string{"for-trade"}},
+		{name: "removes", tags: []string{"starter", "shiny"}, param: []string{"1", "starter"}, expected: []string{"shiny"}},
+		{name: "missing label", param: []string{"1"}, wantErr: true},
+		{name: "not owned", param: []string{"9", "starter"}, wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cfg := ownedConfig("", 1)
+			cfg.caughtPokemons[1].Tags = c.tags
+
+			err := commandTag(nil, cfg, c.param)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("Expected error %v, got: %v", c.wantErr, err)
+			}
+			if c.wantErr {
+				return
+			}
+			if actual := cfg.caughtPokemons[1].Tags; !reflect.DeepEqual(actual, c.expected) {
+				t.Errorf("Expected tags %v, got %v", c.expected, actual)
+			}
+		})
+	}
+}
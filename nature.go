@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// commandNature expects a nature name and prints the stats it raises and
+// lowers along with its flavor preferences.
+// Pokedex > nature adamant
+// Nature: adamant
+// Increases: attack
+// Decreases: special-attack
+// Likes: spicy
+// Hates: dry
+func commandNature(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("please specify a nature")
+	}
+
+	nature, err := pokeapi.GetNature(param[0])
+	if err != nil {
+		return fmt.Errorf("could not find nature '%s': %v", param[0], err)
+	}
+
+	fmt.Printf("Nature: %s\n", nature.Name)
+	if nature.IncreasedStat == nil || nature.DecreasedStat == nil || nature.IncreasedStat.Name == nature.DecreasedStat.Name {
+		fmt.Println("Neutral nature: no stat changes and no flavor preference")
+		return nil
+	}
+	fmt.Printf("Increases: %s\n", nature.IncreasedStat.Name)
+	fmt.Printf("Decreases: %s\n", nature.DecreasedStat.Name)
+	if nature.LikesFlavor != nil {
+		fmt.Printf("Likes: %s\n", nature.LikesFlavor.Name)
+	}
+	if nature.HatesFlavor != nil {
+		fmt.Printf("Hates: %s\n", nature.HatesFlavor.Name)
+	}
+
+	return nil
+}
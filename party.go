@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/markcromwell/pokedexcli/internal/game"
+)
+
+// parseInstanceID parses the instance ID of an owned Pokemon.
+func (cfg *config) parseInstanceID(word string) (*game.CaughtPokemon, error) {
+	id, err := strconv.Atoi(word)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a Pokemon ID, see the pokedex command for IDs", word)
+	}
+	pokemon, found := cfg.caughtPokemons[id]
+	if !found {
+		return nil, fmt.Errorf("you don't own a Pokemon with ID %d", id)
+	}
+	return pokemon, nil
+}
+
+// printPokemonList prints one line per Pokemon ID, numbered from 1.
+func printPokemonList(cfg *config, ids []int) {
+	for i, id := range ids {
+		pokemon := cfg.caughtPokemons[id]
+		fmt.Printf(" %2d. [ID %d] %s Lv %d\n", i+1, id, pokemon.DisplayName(), pokemon.Level)
+	}
+}
+
+// commandParty takes no parameters and lists the Pokemon in the party.
+// Pokedex > party
+// Your party (2/6):
+//  1. [ID 1] pidgey Lv 5
+//  2. [ID 4] rattata Lv 3
+func commandParty(commands map[string]cliCommand, cfg *config, param []string) error {
+	fmt.Printf("Your party (%d/%d):\n", len(cfg.storage.Party), game.PartySize)
+	if len(cfg.storage.Party) == 0 {
+		fmt.Println(" (empty)")
+		return nil
+	}
+	printPokemonList(cfg, cfg.storage.Party)
+	return nil
+}
+
+// commandBox takes an optional box number (1 by default) and lists the
+// Pokemon in that PC box.
+// Pokedex > box 1
+// Box 1 (1/30):
+//  1. [ID 7] caterpie Lv 5
+func commandBox(commands map[string]cliCommand, cfg *config, param []string) error {
+	n := 1
+	if len(param) > 0 {
+		var err error
+		n, err = strconv.Atoi(param[0])
+		if err != nil || n < 1 || n > len(cfg.storage.Boxes) {
+			return fmt.Errorf("please specify a box between 1 and %d", len(cfg.storage.Boxes))
+		}
+	}
+
+	box := cfg.storage.Boxes[n-1]
+	fmt.Printf("Box %d (%d/%d):\n", n, len(box), game.BoxSize)
+	if len(box) == 0 {
+		fmt.Println(" (empty)")
+		return nil
+	}
+	printPokemonList(cfg, box)
+	return nil
+}
+
+// commandDeposit expects the ID of a party Pokemon and moves it to the PC.
+// Pokedex > deposit 4
+// rattata was deposited in Box 1.
+func commandDeposit(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("please specify the ID of a Pokemon to deposit")
+	}
+	pokemon, err := cfg.parseInstanceID(param[0])
+	if err != nil {
+		return err
+	}
+
+	box, err := cfg.storage.Deposit(pokemon.ID)
+	if err != nil {
+		return err
+	}
+	cfg.autosave()
+	fmt.Printf("%s was deposited in Box %d.\n", pokemon.DisplayName(), box)
+	return nil
+}
+
+// commandWithdraw expects the ID of a Pokemon in the PC and moves it to the party.
+// Pokedex > withdraw 7
+// caterpie joined your party.
+func commandWithdraw(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("please specify the ID of a Pokemon to withdraw")
+	}
+	pokemon, err := cfg.parseInstanceID(param[0])
+	if err != nil {
+		return err
+	}
+
+	if err := cfg.storage.Withdraw(pokemon.ID); err != nil {
+		return err
+	}
+	cfg.autosave()
+	fmt.Printf("%s joined your party.\n", pokemon.DisplayName())
+	return nil
+}
+
+// commandSwap expects two Pokemon IDs and swaps their places, either within
+// the party or between the party and the PC.
+// Pokedex > swap 1 7
+// Swapped pidgey and caterpie.
+func commandSwap(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) < 2 {
+		return fmt.Errorf("please specify the IDs of two Pokemon to swap")
+	}
+	a, err := cfg.parseInstanceID(param[0])
+	if err != nil {
+		return err
+	}
+	b, err := cfg.parseInstanceID(param[1])
+	if err != nil {
+		return err
+	}
+
+	if err := cfg.storage.Swap(a.ID, b.ID); err != nil {
+		return err
+	}
+	cfg.autosave()
+	fmt.Printf("Swapped %s and %s.\n", a.DisplayName(), b.DisplayName())
+	return nil
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// prefetchBudget is the most resources warmed after a single command.
+const prefetchBudget = 25
+
+// prefetchConcurrency is how many resources are warmed at once.
+const prefetchConcurrency = 4
+
+// prefetch warms the cache in the background for the given URLs, so the
+// command the user is likely to type next doesn't wait on the network. It does
+// nothing unless prefetching is enabled. At most prefetchBudget URLs are
+// fetched, and the work is cancelled by stopPrefetch when the next command runs.
+func (cfg *config) prefetch(urls []string) {
+	if !cfg.prefetchEnabled || len(urls) == 0 {
+		return
+	}
+
+	cfg.stopPrefetch()
+	ctx, cancel := context.WithCancel(context.Background())
+	cfg.cancelPrefetch = cancel
+
+	urls = urls[:min(len(urls), prefetchBudget)]
+	go pokeapi.Batch(ctx, urls, prefetchConcurrency, func(ctx context.Context, url string) ([]byte, error) {
+		return pokeapi.GetContext(ctx, url)
+	})
+}
+
+// stopPrefetch cancels any prefetching still in progress.
+func (cfg *config) stopPrefetch() {
+	if cfg.cancelPrefetch != nil {
+		cfg.cancelPrefetch()
+		cfg.cancelPrefetch = nil
+	}
+}
+
+// commandPrefetch takes an optional "on" or "off". Without one it prints
+// whether background prefetching is enabled.
+// Pokedex > prefetch on
+// Prefetching enabled
+func commandPrefetch(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) > 0 {
+		switch param[0] {
+		case "on":
+			cfg.prefetchEnabled = true
+		case "off":
+			cfg.prefetchEnabled = false
+			cfg.stopPrefetch()
+		default:
+			return fmt.Errorf("usage: prefetch [on|off]")
+		}
+		cfg.autosave()
+	}
+
+	if cfg.prefetchEnabled {
+		fmt.Println("Prefetching enabled")
+	} else {
+		fmt.Println("Prefetching disabled")
+	}
+
+	return nil
+}
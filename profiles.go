@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/markcromwell/pokedexcli/internal/game"
+	"github.com/markcromwell/pokedexcli/internal/save"
+)
+
+// maxHistory is how many past commands are kept per profile.
+const maxHistory = 100
+
+// recordHistory appends a command line to the profile's history.
+func (cfg *config) recordHistory(line string) {
+	cfg.history = append(cfg.history, line)
+	if len(cfg.history) > maxHistory {
+		cfg.history = cfg.history[len(cfg.history)-maxHistory:]
+	}
+}
+
+// switchProfile loads the named profile, starting it fresh if it has no save
+// yet, and only once that succeeds saves the current profile and switches to
+// it. A save that can't be read leaves the current profile in place, so its
+// progress is never written over the other profile's file.
+func (cfg *config) switchProfile(name string) error {
+	path, err := save.ProfilePath(name)
+	if err != nil {
+		return err
+	}
+	file, err := readSave(path)
+	if err != nil {
+		return err
+	}
+
+	cfg.autosave()
+	cfg.stopPrefetch()
+	cfg.profile = name
+	cfg.savePath = path
+	cfg.nextURL, cfg.prevURL = nil, nil
+	cfg.applySave(file)
+	return nil
+}
+
+// commandProfile manages trainer profiles, each with its own caught Pokemon,
+// settings and history. Profile names keep the case they are typed in.
+// Pokedex > profile create misty
+// Created profile misty
+// Pokedex > profile switch misty
+// Switched to profile misty
+func commandProfile(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		fmt.Printf("Current profile: %s\n", cfg.profile)
+		return nil
+	}
+
+	action := param[0]
+	if action == "list" {
+		profiles, err := save.ListProfiles()
+		if err != nil {
+			return err
+		}
+		fmt.Println("Profiles:")
+		for _, name := range profiles {
+			if name == cfg.profile {
+				fmt.Printf(" - %s (current)\n", name)
+			} else {
+				fmt.Printf(" - %s\n", name)
+			}
+		}
+		return nil
+	}
+
+	if len(param) < 2 {
+		return fmt.Errorf("usage: profile list|create <name>|switch <name>|delete <name>")
+	}
+	name := cfg.rawArg(param, 1)
+	exists, err := save.ProfileExists(name)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "create":
+		if exists {
+			return fmt.Errorf("profile '%s' already exists", name)
+		}
+		path, err := save.ProfilePath(name)
+		if err != nil {
+			return err
+		}
+		err = save.Write(path, &save.File{Profile: name, Money: game.StartingMoney, Settings: save.Settings{Lang: cfg.lang}})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Created profile %s\n", name)
+	case "switch":
+		if !exists {
+			return fmt.Errorf("profile '%s' does not exist, create it first", name)
+		}
+		if err := cfg.switchProfile(name); err != nil {
+			return err
+		}
+		fmt.Printf("Switched to profile %s (%d Pokemon owned)\n", name, len(cfg.caughtPokemons))
+	case "delete":
+		if !exists {
+			return fmt.Errorf("profile '%s' does not exist", name)
+		}
+		if name == cfg.profile {
+			return fmt.Errorf("cannot delete the current profile, switch to another one first")
+		}
+		if err := save.DeleteProfile(name); err != nil {
+			return err
+		}
+		fmt.Printf("Deleted profile %s\n", name)
+	default:
+		return fmt.Errorf("unknown action '%s', expected list, create, switch or delete", action)
+	}
+
+	return nil
+}
+
+// commandHistory takes no parameters and prints the current profile's past commands.
+func commandHistory(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(cfg.history) == 0 {
+		fmt.Println("No history yet.")
+		return nil
+	}
+
+	for i, line := range cfg.history {
+		fmt.Printf("%3d  %s\n", i+1, line)
+	}
+	return nil
+}
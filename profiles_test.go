@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/markcromwell/pokedexcli/internal/save"
+)
+
+func TestSwitchProfileUnreadableSave(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	ashPath, err := save.ProfilePath("ash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mistyPath, err := save.ProfilePath("misty")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a save from a newer build can't be loaded
+	misty := []byte(`{"version": 10, "profile": "misty"}`)
+	if err := os.MkdirAll(filepath.Dir(mistyPath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(mistyPath, misty, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := ownedConfig("", 1)
+	cfg.profile, cfg.savePath = "ash", ashPath
+	if err := commandProfile(nil, cfg, []string{"switch", "misty"}); err == nil {
+		t.Fatal("Expected an error switching to an unreadable save")
+	}
+	if cfg.profile != "ash" || cfg.savePath != ashPath || len(cfg.caughtPokemons) != 1 {
+		t.Errorf("Expected to stay on profile ash with its Pokemon, got %s at %s with %d Pokemon", cfg.profile, cfg.savePath, len(cfg.caughtPokemons))
+	}
+
+	cfg.autosave()
+	data, err := os.ReadFile(mistyPath)
+	if err != nil || !bytes.Equal(data, misty) {
+		t.Errorf("Expected misty's save to be left alone, got %q (err %v)", data, err)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// defaultPokedex is the Pokedex progress is measured against when none is given.
+const defaultPokedex = "national"
+
+// commandProgress takes an optional Pokedex name (national by default) and
+// prints how much of it has been caught, followed by the missing entries.
+// Pokedex > progress kanto
+// kanto Pokedex: 2/151 caught (1.3%)
+// Missing:
+//   - #002 ivysaur
+//   - #003 venusaur
+func commandProgress(commands map[string]cliCommand, cfg *config, param []string) error {
+	pokedexName := defaultPokedex
+	if len(param) > 0 {
+		pokedexName = param[0]
+	}
+
+	pokedex, err := pokeapi.GetPokedex(pokedexName)
+	if err != nil {
+		return fmt.Errorf("could not find Pokedex '%s': %v", pokedexName, err)
+	}
+
+	caught := cfg.speciesCaught
+	var missing []string
+	for _, entry := range pokedex.PokemonEntries {
+		if !caught[entry.PokemonSpecies.Name] {
+			missing = append(missing, fmt.Sprintf("#%03d %s", entry.EntryNumber, entry.PokemonSpecies.Name))
+		}
+	}
+
+	total := len(pokedex.PokemonEntries)
+	done := total - len(missing)
+	percent := 0.0
+	if total > 0 {
+		percent = float64(done) / float64(total) * 100
+	}
+	fmt.Printf("%s Pokedex: %d/%d caught (%.1f%%)\n", pokedex.Name, done, total, percent)
+
+	if len(missing) == 0 {
+		fmt.Println("Complete! You have caught them all.")
+		return nil
+	}
+	fmt.Println("Missing:")
+	for _, m := range missing {
+		fmt.Printf(" - %s\n", m)
+	}
+
+	return nil
+}
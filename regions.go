@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// commandRegions takes no parameters and lists every region.
+// Pokedex > regions
+// Regions:
+//   - kanto
+//   - johto
+func commandRegions(commands map[string]cliCommand, cfg *config, param []string) error {
+	regions, err := pokeapi.GetRegions()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Regions:")
+	for _, region := range regions.Results {
+		fmt.Printf(" - %s\n", region.Name)
+	}
+
+	return nil
+}
+
+// commandRegion expects a region name and lists the locations in it.
+// Pokedex > region kanto
+// Locations in kanto:
+//   - celadon-city
+//   - cerulean-city
+func commandRegion(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("please specify a region")
+	}
+
+	region, err := pokeapi.GetRegion(param[0])
+	if err != nil {
+		return fmt.Errorf("could not find region '%s': %v", param[0], err)
+	}
+
+	fmt.Printf("Locations in %s:\n", region.Name)
+	for _, location := range region.Locations {
+		fmt.Printf(" - %s\n", location.Name)
+	}
+	fmt.Println("Use 'location <name>' to see its areas.")
+
+	return nil
+}
+
+// commandLocation expects a location name and lists the location areas in it,
+// which can then be explored.
+// Pokedex > location pallet-town
+// Areas in pallet-town (kanto):
+//   - pallet-town-area
+func commandLocation(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("please specify a location")
+	}
+
+	location, err := pokeapi.GetLocation(param[0])
+	if err != nil {
+		return fmt.Errorf("could not find location '%s': %v", param[0], err)
+	}
+
+	fmt.Printf("Areas in %s (%s):\n", location.Name, location.Region.Name)
+	if len(location.Areas) == 0 {
+		fmt.Println(" (no explorable areas)")
+		return nil
+	}
+	for _, area := range location.Areas {
+		fmt.Printf(" - %s\n", area.Name)
+	}
+	fmt.Println("Use 'explore <area>' to see its Pokemon.")
+
+	return nil
+}
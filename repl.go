@@ -12,3 +12,47 @@ func cleanInput(text string) []string {
 	words := strings.Fields(text)
 	return words
 }
+
+// rawInput splits a command line into words like cleanInput but keeps their
+// case, for arguments such as file paths and nicknames.
+func rawInput(text string) []string {
+	return strings.Fields(text)
+}
+
+// rawArg returns parameter i of the current command in its original case. It
+// falls back to the lowercased parameter when the original words don't line
+// up with param, e.g. when a command is run other than from the prompt.
+func (cfg *config) rawArg(param []string, i int) string {
+	if len(cfg.rawArgs) == len(param) {
+		return cfg.rawArgs[i]
+	}
+	return param[i]
+}
+
+// parseArgs splits command parameters into positional arguments and flags.
+// Flags may be written as "--name value" or "--name=value"; a flag with no
+// value is recorded as "true".
+func parseArgs(param []string) ([]string, map[string]string) {
+	args := []string{}
+	flags := map[string]string{}
+	for i := 0; i < len(param); i++ {
+		word := param[i]
+		if !strings.HasPrefix(word, "--") {
+			args = append(args, word)
+			continue
+		}
+
+		name := strings.TrimPrefix(word, "--")
+		if key, value, found := strings.Cut(name, "="); found {
+			flags[key] = value
+			continue
+		}
+		if i+1 < len(param) && !strings.HasPrefix(param[i+1], "--") {
+			flags[name] = param[i+1]
+			i++
+			continue
+		}
+		flags[name] = "true"
+	}
+	return args, flags
+}
@@ -1,7 +1,11 @@
 // repl_test.go
 package main
 
-import "testing"
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
 
 func TestCleanInput(t *testing.T) {
 	cases := []struct {
@@ -49,3 +53,59 @@ func TestCleanInput(t *testing.T) {
 		})
 	}
 }
+
+func TestParseArgs(t *testing.T) {
+	cases := []struct {
+		input         []string
+		expectedArgs  []string
+		expectedFlags map[string]string
+	}{
+		{
+			input:         []string{"pikachu"},
+			expectedArgs:  []string{"pikachu"},
+			expectedFlags: map[string]string{},
+		},
+		{
+			input:         []string{"pikachu", "--version", "red"},
+			expectedArgs:  []string{"pikachu"},
+			expectedFlags: map[string]string{"version": "red"},
+		},
+		{
+			input:         []string{"--version=blue", "pikachu", "--all"},
+			expectedArgs:  []string{"pikachu"},
+			expectedFlags: map[string]string{"version": "blue", "all": "true"},
+		},
+		{
+			input:         []string{"--all", "--method", "surf"},
+			expectedArgs:  []string{},
+			expectedFlags: map[string]string{"all": "true", "method": "surf"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(strings.Join(c.input, " "), func(t *testing.T) {
+			args, flags := parseArgs(c.input)
+			if !reflect.DeepEqual(args, c.expectedArgs) {
+				t.Errorf("Expected args %v, got %v", c.expectedArgs, args)
+			}
+			if !reflect.DeepEqual(flags, c.expectedFlags) {
+				t.Errorf("Expected flags %v, got %v", c.expectedFlags, flags)
+			}
+		})
+	}
+}
+
+func TestRawArg(t *testing.T) {
+	command := "  load  ~/Saves/Game.json "
+	input := cleanInput(command)
+
+	cfg := &config{rawArgs: rawInput(command)[1:]}
+	if actual := cfg.rawArg(input[1:], 0); actual != "~/Saves/Game.json" {
+		t.Errorf("Expected the original case, got %q", actual)
+	}
+
+	cfg.rawArgs = nil
+	if actual := cfg.rawArg(input[1:], 0); actual != "~/saves/game.json" {
+		t.Errorf("Expected the lowercased parameter without raw words, got %q", actual)
+	}
+}
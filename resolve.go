@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/markcromwell/pokedexcli/internal/fuzzy"
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// resolveResource turns user input into the slug of a resource of the given
+// type (e.g. "pokemon", "location-area"). IDs such as "25" or "#25" are passed
+// through as PokeAPI accepts them in place of names. Localized names are looked up next.
+// Otherwise the words are joined with hyphens and matched against every name
+// from the resource's list endpoint: exact matches and unambiguous prefixes
+// resolve, typos produce a "did you mean" error.
+func (cfg *config) resolveResource(resource string, words []string) (string, error) {
+	if len(words) == 1 {
+		if id, ok := parseID(words[0]); ok {
+			return strconv.Itoa(id), nil
+		}
+	}
+	if slug, found := cfg.names.lookup(resource, words); found {
+		return slug, nil
+	}
+
+	input := strings.Join(words, "-")
+	names, err := pokeapi.GetResourceNames(resource)
+	if err != nil {
+		// without the index, let the lookup itself report whether the name exists
+		return input, nil
+	}
+
+	result := fuzzy.Match(input, names)
+	if result.Resolved != "" {
+		if result.Resolved != input {
+			fmt.Printf("(assuming %s)\n", result.Resolved)
+		}
+		return result.Resolved, nil
+	}
+	if len(result.Suggestions) > 0 {
+		return "", fmt.Errorf("could not find %s '%s', did you mean %s?", resource, input, strings.Join(result.Suggestions, " or "))
+	}
+	return "", fmt.Errorf("could not find %s '%s'", resource, input)
+}
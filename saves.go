@@ -0,0 +1,174 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/markcromwell/pokedexcli/internal/game"
+	"github.com/markcromwell/pokedexcli/internal/save"
+)
+
+// saveFile captures the parts of config that are persisted.
+func (cfg *config) saveFile() *save.File {
+	return &save.File{
+		Profile:       cfg.profile,
+		CaughtPokemon: cfg.ownedPokemon(),
+		NextID:        cfg.nextPokemonID,
+		SpeciesCaught: sortedKeys(cfg.speciesCaught),
+		Storage:       cfg.storage,
+		Bag:           cfg.bag,
+		Money:         cfg.money,
+		Location:      cfg.location,
+		Settings: save.Settings{
+			Lang:     cfg.lang,
+			Prefetch: cfg.prefetchEnabled,
+		},
+		History: cfg.history,
+	}
+}
+
+// applySave replaces the persisted parts of config with the contents of file.
+func (cfg *config) applySave(file *save.File) {
+	cfg.caughtPokemons = make(map[int]*game.CaughtPokemon, len(file.CaughtPokemon))
+	for _, pokemon := range file.CaughtPokemon {
+		cfg.caughtPokemons[pokemon.ID] = pokemon
+	}
+	cfg.nextPokemonID = nextFreeID(cfg.caughtPokemons, file.NextID)
+	cfg.storage = file.Storage
+	if cfg.storage == nil {
+		cfg.storage = game.NewStorage()
+	}
+	ids := make([]int, 0, len(file.CaughtPokemon))
+	for _, pokemon := range file.CaughtPokemon {
+		ids = append(ids, pokemon.ID)
+	}
+	cfg.storage.Reconcile(ids)
+	cfg.bag = file.Bag
+	if cfg.bag == nil {
+		cfg.bag = game.StarterBag()
+	}
+	cfg.money = file.Money
+	cfg.location = file.Location
+	cfg.wild = nil
+	cfg.speciesCaught = make(map[string]bool, len(file.SpeciesCaught))
+	for _, species := range file.SpeciesCaught {
+		cfg.speciesCaught[species] = true
+	}
+	cfg.lang = file.Settings.Lang
+	cfg.prefetchEnabled = file.Settings.Prefetch
+	cfg.history = file.History
+}
+
+// readSave loads the save at path. A missing save is a new game with default
+// settings and the starting money.
+func readSave(path string) (*save.File, error) {
+	file, err := save.Load(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &save.File{Money: game.StartingMoney}, nil
+	}
+	return file, err
+}
+
+// loadSave loads the save at cfg.savePath, starting a new game if there is none.
+func (cfg *config) loadSave() error {
+	file, err := readSave(cfg.savePath)
+	if err != nil {
+		return err
+	}
+
+	cfg.applySave(file)
+	return nil
+}
+
+// sortedKeys returns the keys of a set in sorted order.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// autosave writes the save file, warning rather than failing the command if it can't.
+func (cfg *config) autosave() {
+	if cfg.savePath == "" {
+		return
+	}
+	if err := save.Write(cfg.savePath, cfg.saveFile()); err != nil {
+		fmt.Printf("Warning: could not save your progress: %v\n", err)
+	}
+}
+
+// commandSave takes no parameters and writes the save file.
+// Pokedex > save
+// Saved to /home/ash/.config/pokedexcli/save.json
+func commandSave(commands map[string]cliCommand, cfg *config, param []string) error {
+	if cfg.savePath == "" {
+		return fmt.Errorf("no save file location available")
+	}
+
+	err := save.Write(cfg.savePath, cfg.saveFile())
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Saved to %s\n", cfg.savePath)
+	return nil
+}
+
+// commandLoad expects the path of a save file, taken in its original case, and
+// restores the Pokedex from it. The file itself is never changed, even when it
+// is from an older version; progress keeps being saved to the usual save file.
+// Pokedex > load backup.json
+// Loaded 12 Pokemon from backup.json
+func commandLoad(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("please specify a save file to load")
+	}
+
+	path := cfg.rawArg(param, 0)
+	file, err := save.Read(path)
+	if err != nil {
+		return err
+	}
+
+	cfg.applySave(file)
+	cfg.autosave()
+	fmt.Printf("Loaded %d Pokemon from %s\n", len(cfg.caughtPokemons), path)
+	return nil
+}
+
+// commandNewGame takes no parameters and starts the current profile over with
+// an empty Pokedex, the starter items and the starting money, keeping its
+// settings and history. The previous save is kept next to the save file with
+// a .bak extension.
+// Pokedex > new-game
+// Started a new game. Your previous save was kept in save.json.bak
+func commandNewGame(commands map[string]cliCommand, cfg *config, param []string) error {
+	backup := ""
+	if cfg.savePath != "" {
+		backup = cfg.savePath + ".bak"
+		if err := save.Write(backup, cfg.saveFile()); err != nil {
+			return fmt.Errorf("could not back up the current save: %v", err)
+		}
+	}
+
+	cfg.caughtPokemons = make(map[int]*game.CaughtPokemon)
+	cfg.nextPokemonID = 1
+	cfg.speciesCaught = make(map[string]bool)
+	cfg.storage = game.NewStorage()
+	cfg.bag = game.StarterBag()
+	cfg.money = game.StartingMoney
+	cfg.location = ""
+	cfg.wild = nil
+	cfg.autosave()
+
+	fmt.Println("Started a new game.")
+	if backup != "" {
+		fmt.Printf("Your previous save was kept in %s\n", backup)
+	}
+	return nil
+}
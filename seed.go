@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+)
+
+// reseed replaces the session's RNG with one seeded with seed. Every random
+// roll in the game goes through cfg.rng, so the same seed and the same
+// commands play out the same way.
+func (cfg *config) reseed(seed int64) {
+	cfg.seed = seed
+	cfg.rng = rand.New(rand.NewSource(seed))
+}
+
+// randomSeed picks a seed for sessions started without --seed.
+func randomSeed() int64 {
+	return time.Now().UnixNano()
+}
+
+// commandSeed prints the seed the session's RNG was last seeded with, or
+// reseeds it with the number given.
+func commandSeed(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) > 0 {
+		seed, err := strconv.ParseInt(param[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("usage: seed [number]")
+		}
+		cfg.reseed(seed)
+		fmt.Printf("Random number generator reseeded with %d\n", seed)
+		return nil
+	}
+
+	fmt.Printf("Seed: %d\n", cfg.seed)
+	return nil
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/markcromwell/pokedexcli/internal/game"
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// maxQuantity is the most of an item that can be bought or sold at once.
+const maxQuantity = 999
+
+// parseQuantity splits an optional trailing quantity off the words naming an
+// item, defaulting to 1. Quantities above maxQuantity are refused.
+func parseQuantity(words []string) ([]string, int, error) {
+	if len(words) < 2 {
+		return words, 1, nil
+	}
+	qty, err := strconv.Atoi(words[len(words)-1])
+	if err != nil {
+		return words, 1, nil
+	}
+	if qty < 1 || qty > maxQuantity {
+		return nil, 0, fmt.Errorf("quantity must be between 1 and %d, got %s", maxQuantity, words[len(words)-1])
+	}
+	return words[:len(words)-1], qty, nil
+}
+
+// commandShop takes no parameters and lists the Poke Mart's stock with
+// prices, using item names in the current language.
+// Pokedex > shop
+// You have $3000
+// Poke Ball       $200
+// Great Ball      $600
+func commandShop(commands map[string]cliCommand, cfg *config, param []string) error {
+	fmt.Printf("You have $%d\n", cfg.money)
+	for _, slug := range game.ShopStock {
+		item, err := pokeapi.GetItem(slug)
+		if err != nil {
+			return fmt.Errorf("could not get item '%s': %v", slug, err)
+		}
+		fmt.Printf("%-16s $%d\n", cfg.localize("item", slug, item.Names), item.Cost)
+	}
+	return nil
+}
+
+// commandBuy expects an item sold in the Poke Mart and an optional quantity,
+// and puts the items in the bag if there is enough money.
+// Pokedex > buy great ball 2
+// Bought 2 Great Ball for $1200. You have $1800 left.
+func commandBuy(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("usage: buy <item> [qty]")
+	}
+	words, qty, err := parseQuantity(param)
+	if err != nil {
+		return err
+	}
+
+	slug, err := cfg.resolveResource("item", words)
+	if err != nil {
+		return err
+	}
+	if !game.InStock(slug) {
+		return fmt.Errorf("the Poke Mart doesn't sell %s", slug)
+	}
+
+	item, err := pokeapi.GetItem(slug)
+	if err != nil {
+		return fmt.Errorf("could not get item '%s': %v", slug, err)
+	}
+	if item.Cost > 0 && qty > cfg.money/item.Cost {
+		return fmt.Errorf("%d %s cost $%d but you only have $%d", qty, slug, item.Cost*qty, cfg.money)
+	}
+	total := item.Cost * qty
+
+	cfg.money -= total
+	cfg.bag.Add(slug, qty)
+	cfg.autosave()
+	fmt.Printf("Bought %d %s for $%d. You have $%d left.\n", qty, cfg.localize("item", slug, item.Names), total, cfg.money)
+	return nil
+}
+
+// commandSell expects an item in the bag and an optional quantity, and sells
+// them to the Poke Mart for half their price.
+// Pokedex > sell potion
+// Sold 1 Potion for $100. You have $3100.
+func commandSell(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("usage: sell <item> [qty]")
+	}
+	words, qty, err := parseQuantity(param)
+	if err != nil {
+		return err
+	}
+
+	slug, err := cfg.resolveResource("item", words)
+	if err != nil {
+		return err
+	}
+	if cfg.bag.Count(slug) < qty {
+		return fmt.Errorf("you only have %d %s", cfg.bag.Count(slug), slug)
+	}
+
+	item, err := pokeapi.GetItem(slug)
+	if err != nil {
+		return fmt.Errorf("could not get item '%s': %v", slug, err)
+	}
+	price := game.SellPrice(item.Cost)
+	if price == 0 {
+		return fmt.Errorf("%s can't be sold", slug)
+	}
+
+	if err := cfg.bag.Remove(slug, qty); err != nil {
+		return err
+	}
+	cfg.money += price * qty
+	cfg.autosave()
+	fmt.Printf("Sold %d %s for $%d. You have $%d.\n", qty, cfg.localize("item", slug, item.Names), price*qty, cfg.money)
+	return nil
+}
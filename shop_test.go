@@ -0,0 +1,42 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseQuantity(t *testing.T) {
+	cases := []struct {
+		input         []string
+		expectedWords []string
+		expectedQty   int
+		wantErr       bool
+	}{
+		{input: []string{"potion"}, expectedWords: []string{"potion"}, expectedQty: 1},
+		{input: []string{"great", "ball", "3"}, expectedWords: []string{"great", "ball"}, expectedQty: 3},
+		{input: []string{"great-ball", "12"}, expectedWords: []string{"great-ball"}, expectedQty: 12},
+		{input: []string{"potion", "0"}, wantErr: true},
+		{input: []string{"potion", "999"}, expectedWords: []string{"potion"}, expectedQty: 999},
+		{input: []string{"potion", "1000"}, wantErr: true},
+		{input: []string{"poke-ball", "50000000000000000"}, wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(strings.Join(c.input, " "), func(t *testing.T) {
+			words, qty, err := parseQuantity(c.input)
+			if c.wantErr {
+				if err == nil {
+					t.Errorf("Expected an error, got %v x%d", words, qty)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if !reflect.DeepEqual(words, c.expectedWords) || qty != c.expectedQty {
+				t.Errorf("Expected %v x%d, got %v x%d", c.expectedWords, c.expectedQty, words, qty)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// currentArea fetches the location area the player is in.
+func (cfg *config) currentArea() (*pokeapi.LocationArea, error) {
+	if cfg.location == "" {
+		return nil, fmt.Errorf("you aren't anywhere yet, use travel <location-area> first")
+	}
+	return pokeapi.GetLocationArea(cfg.location)
+}
+
+// foundIn reports whether a Pokemon is listed among a location area's encounters.
+func foundIn(area *pokeapi.LocationArea, pokemon string) bool {
+	for _, encounter := range area.PokemonEncounters {
+		if encounter.Pokemon.Name == pokemon {
+			return true
+		}
+	}
+	return false
+}
+
+// commandTravel expects the name of a location area and moves the player
+// there. Only Pokemon found in the current area can be caught.
+// Pokedex > travel pallet town
+// (assuming pallet-town-area)
+// You traveled to Pallet Town. 3 kinds of Pokemon live here.
+func commandTravel(commands map[string]cliCommand, cfg *config, param []string) error {
+	if len(param) == 0 {
+		return fmt.Errorf("please specify a location area to travel to")
+	}
+
+	areaName, err := cfg.resolveResource("location-area", param)
+	if err != nil {
+		return err
+	}
+	area, err := pokeapi.GetLocationArea(areaName)
+	if err != nil {
+		return err
+	}
+
+	cfg.location = area.Name
+	cfg.wild = nil
+	cfg.autosave()
+	fmt.Printf("You traveled to %s. %d kinds of Pokemon live here.\n", cfg.localize("location-area", area.Name, area.Names), len(area.PokemonEncounters))
+
+	var urls []string
+	for _, encounter := range area.PokemonEncounters {
+		urls = append(urls, pokeapi.ResourceURL("pokemon", encounter.Pokemon.Name))
+	}
+	cfg.prefetch(urls)
+
+	return nil
+}
+
+// commandWhereami takes no parameters and prints the location area the player
+// is in and the location it belongs to.
+// Pokedex > whereami
+// You are in Pallet Town (pallet-town)
+func commandWhereami(commands map[string]cliCommand, cfg *config, param []string) error {
+	area, err := cfg.currentArea()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("You are in %s (%s)\n", cfg.localize("location-area", area.Name, area.Names), area.Location.Name)
+	return nil
+}
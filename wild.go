@@ -0,0 +1,245 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/markcromwell/pokedexcli/internal/game"
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+// defaultMethod is the encounter method used when none is chosen.
+const defaultMethod = "walk"
+
+// wildEncounter is the wild Pokemon the player is facing. The individual is
+// rolled when it appears, so catching it keeps the level, IVs and nature it
+// battled with. The party lead's HP only lasts for the encounter.
+type wildEncounter struct {
+	pokemon    *pokeapi.Pokemon
+	individual *game.CaughtPokemon
+	hp         int
+	leadID     int
+	leadHP     int
+}
+
+// hpFraction is the share of its HP the wild Pokemon has left.
+func (w *wildEncounter) hpFraction() float64 {
+	return float64(w.hp) / float64(w.individual.Stats.HP)
+}
+
+// encounterSlots collects the encounter slots of an area for a method and
+// version that can happen at the time of day. Without a version the first one
+// offering the method is used, as chances only add up within a single
+// version; it is returned with the slots.
+func encounterSlots(area *pokeapi.LocationArea, method, version, timeOfDay string) ([]game.EncounterSlot, string) {
+	var slots []game.EncounterSlot
+	for _, encounter := range area.PokemonEncounters {
+		for _, v := range encounter.VersionDetails {
+			for _, d := range v.EncounterDetails {
+				if d.Method.Name != method || !conditionsMet(d, timeOfDay) {
+					continue
+				}
+				if version == "" {
+					version = v.Version.Name
+				}
+				if v.Version.Name != version {
+					continue
+				}
+				slots = append(slots, game.EncounterSlot{
+					Pokemon:  encounter.Pokemon.Name,
+					Chance:   d.Chance,
+					MinLevel: d.MinLevel,
+					MaxLevel: d.MaxLevel,
+				})
+			}
+		}
+	}
+	return slots, version
+}
+
+// startEncounter rolls a wild Pokemon in the current area for the method and
+// the --version and --time flags, weighted by each encounter's chance, at a
+// level within its range.
+func (cfg *config) startEncounter(method string, flags map[string]string) error {
+	if cfg.wild != nil {
+		return fmt.Errorf("you are already facing a wild %s, catch it, battle or run", cfg.wild.pokemon.Name)
+	}
+
+	timeOfDay, err := encounterTime(flags)
+	if err != nil {
+		return err
+	}
+	area, err := cfg.currentArea()
+	if err != nil {
+		return err
+	}
+
+	slots, version := encounterSlots(area, method, flags["version"], timeOfDay)
+	if len(slots) == 0 {
+		return fmt.Errorf("no Pokemon can be found in %s by %s", area.Name, method)
+	}
+	slot, level, err := game.RollEncounter(cfg.rng, slots)
+	if err != nil {
+		return err
+	}
+
+	pokemon, err := pokeapi.GetPokemon(slot.Pokemon)
+	if err != nil {
+		return fmt.Errorf("could not find Pokemon '%s': %v", slot.Pokemon, err)
+	}
+	individual, err := cfg.newCaughtPokemon(pokemon, level)
+	if err != nil {
+		return err
+	}
+
+	cfg.wild = &wildEncounter{
+		pokemon:    pokemon,
+		individual: individual,
+		hp:         individual.Stats.HP,
+	}
+	fmt.Printf("A wild %s (Lv %d) appeared! (%s, %s)\n", pokemon.Name, level, method, version)
+	fmt.Println("What will you do? catch, battle or run")
+	return nil
+}
+
+// commandEncounter looks for a wild Pokemon in the current area, by walking
+// unless --method is given, optionally in a given --version and at a given
+// --time of day.
+// Pokedex > encounter --method surf
+// A wild tentacool (Lv 23) appeared! (surf, red)
+// What will you do? catch, battle or run
+func commandEncounter(commands map[string]cliCommand, cfg *config, param []string) error {
+	_, flags := parseArgs(param)
+	method := defaultMethod
+	if value, ok := flags["method"]; ok {
+		method = value
+	}
+	return cfg.startEncounter(method, flags)
+}
+
+// commandWalk looks for a wild Pokemon in the grass of the current area.
+func commandWalk(commands map[string]cliCommand, cfg *config, param []string) error {
+	_, flags := parseArgs(param)
+	return cfg.startEncounter("walk", flags)
+}
+
+// commandSurf looks for a wild Pokemon on the water of the current area.
+func commandSurf(commands map[string]cliCommand, cfg *config, param []string) error {
+	_, flags := parseArgs(param)
+	return cfg.startEncounter("surf", flags)
+}
+
+// commandFish expects a rod, old, good or super, and fishes for a wild
+// Pokemon in the current area.
+func commandFish(commands map[string]cliCommand, cfg *config, param []string) error {
+	args, flags := parseArgs(param)
+	if len(args) == 0 {
+		return fmt.Errorf("usage: fish <old|good|super> [--version x] [--time morning|day|night]")
+	}
+
+	rod := strings.TrimSuffix(args[0], "-rod")
+	switch rod {
+	case "old", "good", "super":
+	default:
+		return fmt.Errorf("unknown rod '%s', expected old, good or super", args[0])
+	}
+	return cfg.startEncounter(rod+"-rod", flags)
+}
+
+// partyLead returns the first Pokemon in the party.
+func (cfg *config) partyLead() (*game.CaughtPokemon, error) {
+	if cfg.storage == nil || len(cfg.storage.Party) == 0 {
+		return nil, fmt.Errorf("you have no Pokemon in your party")
+	}
+	lead, found := cfg.caughtPokemons[cfg.storage.Party[0]]
+	if !found {
+		return nil, fmt.Errorf("you have no Pokemon in your party")
+	}
+	return lead, nil
+}
+
+// commandBattle fights one round against the wild Pokemon with the party
+// lead; the faster one attacks first. Defeating the wild Pokemon earns money
+// and experience and ends the encounter, as does the lead fainting.
+// Pokedex > battle
+// star hit the wild pidgey for 6 damage (11/17 HP left)
+// The wild pidgey hit star for 4 damage (28/32 HP left)
+func commandBattle(commands map[string]cliCommand, cfg *config, param []string) error {
+	wild := cfg.wild
+	if wild == nil {
+		return fmt.Errorf("there is no wild Pokemon to battle, try encounter first")
+	}
+	lead, err := cfg.partyLead()
+	if err != nil {
+		return err
+	}
+	if wild.leadID != lead.ID {
+		wild.leadID = lead.ID
+		wild.leadHP = lead.Stats.HP
+	}
+
+	attack := func() bool {
+		damage := game.Damage(cfg.rng, lead.Level, lead.Stats, wild.individual.Stats)
+		wild.hp = max(wild.hp-damage, 0)
+		fmt.Printf("%s hit the wild %s for %d damage (%d/%d HP left)\n", lead.DisplayName(), wild.pokemon.Name, damage, wild.hp, wild.individual.Stats.HP)
+		return wild.hp == 0
+	}
+	defend := func() bool {
+		damage := game.Damage(cfg.rng, wild.individual.Level, wild.individual.Stats, lead.Stats)
+		wild.leadHP = max(wild.leadHP-damage, 0)
+		fmt.Printf("The wild %s hit %s for %d damage (%d/%d HP left)\n", wild.pokemon.Name, lead.DisplayName(), damage, wild.leadHP, lead.Stats.HP)
+		return wild.leadHP == 0
+	}
+
+	turns := []func() bool{attack, defend}
+	if lead.Stats.Speed < wild.individual.Stats.Speed {
+		turns = []func() bool{defend, attack}
+	}
+	for _, turn := range turns {
+		if !turn() {
+			continue
+		}
+		if wild.hp == 0 {
+			cfg.winBattle(lead)
+		} else {
+			cfg.loseBattle(lead)
+		}
+		break
+	}
+	return nil
+}
+
+// winBattle ends the encounter after the wild Pokemon faints, paying out and
+// giving the party lead experience based on the wild Pokemon's base
+// experience and level.
+func (cfg *config) winBattle(lead *game.CaughtPokemon) {
+	wild := cfg.wild
+	cfg.wild = nil
+
+	reward := game.BattleReward(wild.individual.Level)
+	cfg.money += reward
+	fmt.Printf("The wild %s fainted! You earned $%d.\n", wild.pokemon.Name, reward)
+
+	exp := game.ExpYield(wild.pokemon.BaseExperience, wild.individual.Level)
+	if err := cfg.gainExp(lead, exp); err != nil {
+		fmt.Printf("Warning: %s could not gain experience: %v\n", lead.DisplayName(), err)
+	}
+	cfg.autosave()
+}
+
+// loseBattle ends the encounter after the party lead faints.
+func (cfg *config) loseBattle(lead *game.CaughtPokemon) {
+	wild := cfg.wild
+	cfg.wild = nil
+	fmt.Printf("%s fainted! You hurried away from the wild %s.\n", lead.DisplayName(), wild.pokemon.Name)
+}
+
+// commandRun ends the wild encounter.
+func commandRun(commands map[string]cliCommand, cfg *config, param []string) error {
+	if cfg.wild == nil {
+		return fmt.Errorf("there is no wild Pokemon to run from")
+	}
+	fmt.Printf("Got away safely from the wild %s!\n", cfg.wild.pokemon.Name)
+	cfg.wild = nil
+	return nil
+}
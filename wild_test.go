@@ -0,0 +1,123 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/markcromwell/pokedexcli/internal/game"
+	"github.com/markcromwell/pokedexcli/internal/pokeapi"
+)
+
+func TestEncounterSlots(t *testing.T) {
+	detail := func(method string, chance, minLevel, maxLevel int) pokeapi.EncounterDetail {
+		return pokeapi.EncounterDetail{Method: pokeapi.NamedAPIResource{Name: method}, Chance: chance, MinLevel: minLevel, MaxLevel: maxLevel}
+	}
+	// swarms are off unless chosen, so these never show up
+	swarm := func(d pokeapi.EncounterDetail) pokeapi.EncounterDetail {
+		d.ConditionValues = []pokeapi.NamedAPIResource{{Name: "swarm-yes"}}
+		return d
+	}
+	version := func(name string, details ...pokeapi.EncounterDetail) pokeapi.VersionEncounterDetail {
+		return pokeapi.VersionEncounterDetail{Version: pokeapi.NamedAPIResource{Name: name}, EncounterDetails: details}
+	}
+	area := &pokeapi.LocationArea{
+		PokemonEncounters: []pokeapi.PokemonEncounter{
+			{
+				Pokemon:        pokeapi.NamedAPIResource{Name: "tentacool"},
+				VersionDetails: []pokeapi.VersionEncounterDetail{version("red", detail("surf", 100, 5, 40))},
+			},
+			{
+				Pokemon:        pokeapi.NamedAPIResource{Name: "mawile"},
+				VersionDetails: []pokeapi.VersionEncounterDetail{version("red", swarm(detail("walk", 40, 4, 4)))},
+			},
+			{
+				Pokemon: pokeapi.NamedAPIResource{Name: "pidgey"},
+				VersionDetails: []pokeapi.VersionEncounterDetail{
+					version("red", detail("walk", 20, 2, 2), detail("walk", 15, 3, 5)),
+					version("blue", detail("walk", 30, 2, 5)),
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		name            string
+		method          string
+		version         string
+		expected        []game.EncounterSlot
+		expectedVersion string
+	}{
+		{
+			name:   "first version offering the method",
+			method: "walk",
+			expected: []game.EncounterSlot{
+				{Pokemon: "pidgey", Chance: 20, MinLevel: 2, MaxLevel: 2},
+				{Pokemon: "pidgey", Chance: 15, MinLevel: 3, MaxLevel: 5},
+			},
+			expectedVersion: "red",
+		},
+		{
+			name:            "chosen version",
+			method:          "walk",
+			version:         "blue",
+			expected:        []game.EncounterSlot{{Pokemon: "pidgey", Chance: 30, MinLevel: 2, MaxLevel: 5}},
+			expectedVersion: "blue",
+		},
+		{
+			name:            "other method",
+			method:          "surf",
+			expected:        []game.EncounterSlot{{Pokemon: "tentacool", Chance: 100, MinLevel: 5, MaxLevel: 40}},
+			expectedVersion: "red",
+		},
+		{
+			name:   "method not found",
+			method: "old-rod",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			slots, version := encounterSlots(area, c.method, c.version, defaultTime)
+			if !reflect.DeepEqual(slots, c.expected) || version != c.expectedVersion {
+				t.Errorf("Expected %v in %q, got %v in %q", c.expected, c.expectedVersion, slots, version)
+			}
+		})
+	}
+}
+
+func TestCommandBattleEmptyParty(t *testing.T) {
+	cfg := ownedConfig("")
+	cfg.wild = &wildEncounter{
+		pokemon:    &pokeapi.Pokemon{Name: "pidgey"},
+		individual: &game.CaughtPokemon{Name: "pidgey", Level: 3, Stats: game.Stats{HP: 15}},
+		hp:         15,
+	}
+
+	if err := commandBattle(nil, cfg, nil); err == nil {
+		t.Error("Expected an error battling without a party")
+	}
+	if cfg.wild == nil {
+		t.Error("Expected the encounter to continue")
+	}
+}
+
+func TestCommandCatchStorageFull(t *testing.T) {
+	cfg := ownedConfig("")
+	for id := 1; id <= game.PartySize+game.BoxCount*game.BoxSize; id++ {
+		cfg.storage.Place(id)
+	}
+	cfg.bag = game.StarterBag()
+	balls := cfg.bag.Count(game.DefaultBall)
+	cfg.wild = &wildEncounter{
+		pokemon:    &pokeapi.Pokemon{Name: "pidgey"},
+		individual: &game.CaughtPokemon{Name: "pidgey", Level: 3, Stats: game.Stats{HP: 15}},
+		hp:         15,
+	}
+
+	if err := commandCatch(nil, cfg, nil); err == nil {
+		t.Error("Expected an error catching with every box full")
+	}
+	if cfg.bag.Count(game.DefaultBall) != balls {
+		t.Errorf("Expected to keep %d balls, got %d", balls, cfg.bag.Count(game.DefaultBall))
+	}
+}